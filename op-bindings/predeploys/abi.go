@@ -0,0 +1,152 @@
+package predeploys
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// lazyABI returns a function that parses the embedded ABI of the binding
+// metadata on first use.
+func lazyABI(metadata *bind.MetaData) func() *abi.ABI {
+	return func() *abi.ABI {
+		parsed, err := metadata.GetAbi()
+		if err != nil {
+			panic(fmt.Errorf("invalid embedded ABI: %w", err))
+		}
+		return parsed
+	}
+}
+
+// PredeployABI returns the ABI of the predeploy with the given name.
+func PredeployABI(name string) (*abi.ABI, error) {
+	predeploy, ok := lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown predeploy %s", name)
+	}
+	if predeploy.ABI == nil {
+		return nil, fmt.Errorf("no ABI for predeploy %s", name)
+	}
+	return predeploy.ABI(), nil
+}
+
+// BindingSpec describes the Go binding of a predeploy for abigen.
+type BindingSpec struct {
+	Name    string
+	Address common.Address
+	// ABIPath is the path of the forge artifact holding the ABI, relative
+	// to the forge artifacts directory, as read by op-bindings/gen.
+	ABIPath string
+}
+
+// BindingsManifest returns the binding specs of the registered predeploys
+// that have an ABI, sorted by name. The predeploy name is the contract name.
+func BindingsManifest() []BindingSpec {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	var specs []BindingSpec
+	for name, predeploy := range Predeploys {
+		if predeploy.ABI == nil {
+			continue
+		}
+		specs = append(specs, BindingSpec{
+			Name:    name,
+			Address: predeploy.Address,
+			ABIPath: path.Join(name+".sol", name+".json"),
+		})
+	}
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Name < specs[j].Name
+	})
+	return specs
+}
+
+// DecodeRevert decodes the revert data of a call to the predeploy at addr.
+// It understands the standard Error(string) and Panic(uint256) reverts as
+// well as the custom errors declared in the ABI of the predeploy.
+func DecodeRevert(addr common.Address, revertData []byte) (string, error) {
+	if reason, err := abi.UnpackRevert(revertData); err == nil {
+		return reason, nil
+	}
+	if len(revertData) < 4 {
+		return "", fmt.Errorf("revert data too short: %d bytes", len(revertData))
+	}
+	predeploy, ok := lookupAddress(addr)
+	if !ok || predeploy.ABI == nil {
+		return "", fmt.Errorf("no ABI for predeploy at %s", addr)
+	}
+	var selector [4]byte
+	copy(selector[:], revertData[:4])
+	abiErr, err := predeploy.ABI().ErrorByID(selector)
+	if err != nil {
+		return "", fmt.Errorf("unknown revert selector %x for predeploy at %s", selector, addr)
+	}
+	args, err := abiErr.Inputs.Unpack(revertData[4:])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode %s: %w", abiErr.Name, err)
+	}
+	if len(args) == 0 {
+		return abiErr.Name + "()", nil
+	}
+	return fmt.Sprintf("%s%v", abiErr.Name, args), nil
+}
+
+// knownInterfaces are the ERC165 interfaces predeploys may implement, as the
+// signatures of their functions.
+var knownInterfaces = map[string][]string{
+	"IERC165":                {"supportsInterface(bytes4)"},
+	"ILegacyMintableERC20":   {"l1Token()", "mint(address,uint256)", "burn(address,uint256)"},
+	"IOptimismMintableERC20": {"remoteToken()", "bridge()", "mint(address,uint256)", "burn(address,uint256)"},
+}
+
+// interfaceID returns the ERC165 interface ID of the functions, the XOR of
+// their selectors.
+func interfaceID(sigs []string) [4]byte {
+	var id [4]byte
+	for _, sig := range sigs {
+		selector := crypto.Keccak256([]byte(sig))
+		for i := range id {
+			id[i] ^= selector[i]
+		}
+	}
+	return id
+}
+
+// ExpectedInterfaceIDs returns the sorted ERC165 interface IDs the predeploy
+// with the given name should report through supportsInterface: IERC165 and
+// every known interface whose functions are all in its ABI. It returns nil
+// for unknown predeploys and for predeploys that do not implement ERC165,
+// which includes the bridges and messengers.
+func ExpectedInterfaceIDs(name string) [][4]byte {
+	parsed, err := PredeployABI(name)
+	if err != nil {
+		return nil
+	}
+	sigs := make(map[string]bool, len(parsed.Methods))
+	for _, method := range parsed.Methods {
+		sigs[method.Sig] = true
+	}
+	if !sigs["supportsInterface(bytes4)"] {
+		return nil
+	}
+
+	var ids [][4]byte
+	for _, fns := range knownInterfaces {
+		implemented := true
+		for _, fn := range fns {
+			implemented = implemented && sigs[fn]
+		}
+		if implemented {
+			ids = append(ids, interfaceID(fns))
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return string(ids[i][:]) < string(ids[j][:])
+	})
+	return ids
+}
@@ -0,0 +1,89 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredeployABI(t *testing.T) {
+	parsed, err := PredeployABI("L2StandardBridge")
+	require.NoError(t, err)
+
+	// withdraw(address,uint256,uint32,bytes)
+	method, err := parsed.MethodById(hexutil.MustDecode("0x32b7006d"))
+	require.NoError(t, err)
+	require.Equal(t, "withdraw", method.Name)
+
+	for name, predeploy := range Predeploys {
+		if predeploy.ABI == nil {
+			continue
+		}
+		_, err := PredeployABI(name)
+		require.NoError(t, err, name)
+	}
+
+	_, err = PredeployABI("Create2Deployer")
+	require.Error(t, err)
+	_, err = PredeployABI("Unknown")
+	require.Error(t, err)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	// Error("StandardBridge: function can only be called from the other bridge")
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	data, err := abi.Arguments{{Type: stringType}}.Pack("StandardBridge: function can only be called from the other bridge")
+	require.NoError(t, err)
+	reason, err := DecodeRevert(L2StandardBridgeAddr, append(hexutil.MustDecode("0x08c379a0"), data...))
+	require.NoError(t, err)
+	require.Equal(t, "StandardBridge: function can only be called from the other bridge", reason)
+
+	// AlreadyExists()
+	reason, err = DecodeRevert(SchemaRegistryAddr, crypto.Keccak256([]byte("AlreadyExists()"))[:4])
+	require.NoError(t, err)
+	require.Equal(t, "AlreadyExists()", reason)
+
+	_, err = DecodeRevert(L2StandardBridgeAddr, hexutil.MustDecode("0xdeadbeef"))
+	require.ErrorContains(t, err, "unknown revert selector deadbeef")
+	_, err = DecodeRevert(common.HexToAddress("0x1234"), hexutil.MustDecode("0xdeadbeef"))
+	require.ErrorContains(t, err, "no ABI")
+}
+
+func TestExpectedInterfaceIDs(t *testing.T) {
+	// The bridges do not implement ERC165.
+	require.Nil(t, ExpectedInterfaceIDs("L2StandardBridge"))
+	require.Nil(t, ExpectedInterfaceIDs("OasysL2ERC721Bridge"))
+	require.Nil(t, ExpectedInterfaceIDs("Unknown"))
+
+	restoreRegistry(t)
+	require.NoError(t, Register("TestMintable", &Predeploy{
+		Address: common.HexToAddress("0x42000000000000000000000000000000000000f0"),
+		ABI:     lazyABI(bindings.OptimismMintableERC20MetaData),
+	}))
+	require.Equal(t, [][4]byte{
+		{0x01, 0xff, 0xc9, 0xa7}, // IERC165
+		{0x1d, 0x1d, 0x8b, 0x63}, // ILegacyMintableERC20
+		{0xec, 0x4f, 0xc8, 0xe3}, // IOptimismMintableERC20
+	}, ExpectedInterfaceIDs("TestMintable"))
+}
+
+func TestBindingsManifest(t *testing.T) {
+	specs := make(map[string]BindingSpec)
+	for _, spec := range BindingsManifest() {
+		specs[spec.Name] = spec
+	}
+	for _, name := range MandatoryPredeploys() {
+		spec, ok := specs[name]
+		require.True(t, ok, name)
+		require.Equal(t, Predeploys[name].Address, spec.Address, name)
+		require.NotEmpty(t, spec.ABIPath, name)
+	}
+	require.Equal(t, "L1Block.sol/L1Block.json", specs["L1Block"].ABIPath)
+	require.NotContains(t, specs, "L2Faucet")
+}
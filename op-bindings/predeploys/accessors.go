@@ -0,0 +1,214 @@
+package predeploys
+
+// Accessors for the registered predeploys. They are suffixed with Predeploy
+// because the bare names are taken by the address constants.
+
+// L2ToL1MessagePasserPredeploy returns the L2ToL1MessagePasser predeploy.
+func L2ToL1MessagePasserPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2ToL1MessagePasser")
+	return predeploy
+}
+
+// DeployerWhitelistPredeploy returns the DeployerWhitelist predeploy.
+func DeployerWhitelistPredeploy() *Predeploy {
+	predeploy, _ := lookup("DeployerWhitelist")
+	return predeploy
+}
+
+// WETH9Predeploy returns the WETH9 predeploy.
+func WETH9Predeploy() *Predeploy {
+	predeploy, _ := lookup("WETH9")
+	return predeploy
+}
+
+// L2CrossDomainMessengerPredeploy returns the L2CrossDomainMessenger predeploy.
+func L2CrossDomainMessengerPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2CrossDomainMessenger")
+	return predeploy
+}
+
+// L2StandardBridgePredeploy returns the L2StandardBridge predeploy.
+func L2StandardBridgePredeploy() *Predeploy {
+	predeploy, _ := lookup("L2StandardBridge")
+	return predeploy
+}
+
+// SequencerFeeVaultPredeploy returns the SequencerFeeVault predeploy.
+func SequencerFeeVaultPredeploy() *Predeploy {
+	predeploy, _ := lookup("SequencerFeeVault")
+	return predeploy
+}
+
+// OptimismMintableERC20FactoryPredeploy returns the OptimismMintableERC20Factory predeploy.
+func OptimismMintableERC20FactoryPredeploy() *Predeploy {
+	predeploy, _ := lookup("OptimismMintableERC20Factory")
+	return predeploy
+}
+
+// L1BlockNumberPredeploy returns the L1BlockNumber predeploy.
+func L1BlockNumberPredeploy() *Predeploy {
+	predeploy, _ := lookup("L1BlockNumber")
+	return predeploy
+}
+
+// GasPriceOraclePredeploy returns the GasPriceOracle predeploy.
+func GasPriceOraclePredeploy() *Predeploy {
+	predeploy, _ := lookup("GasPriceOracle")
+	return predeploy
+}
+
+// L1BlockPredeploy returns the L1Block predeploy.
+func L1BlockPredeploy() *Predeploy {
+	predeploy, _ := lookup("L1Block")
+	return predeploy
+}
+
+// GovernanceTokenPredeploy returns the GovernanceToken predeploy.
+func GovernanceTokenPredeploy() *Predeploy {
+	predeploy, _ := lookup("GovernanceToken")
+	return predeploy
+}
+
+// LegacyMessagePasserPredeploy returns the LegacyMessagePasser predeploy.
+func LegacyMessagePasserPredeploy() *Predeploy {
+	predeploy, _ := lookup("LegacyMessagePasser")
+	return predeploy
+}
+
+// OasysL2ERC721BridgePredeploy returns the OasysL2ERC721Bridge predeploy.
+func OasysL2ERC721BridgePredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysL2ERC721Bridge")
+	return predeploy
+}
+
+// OptimismMintableERC721FactoryPredeploy returns the OptimismMintableERC721Factory predeploy.
+func OptimismMintableERC721FactoryPredeploy() *Predeploy {
+	predeploy, _ := lookup("OptimismMintableERC721Factory")
+	return predeploy
+}
+
+// ProxyAdminPredeploy returns the ProxyAdmin predeploy.
+func ProxyAdminPredeploy() *Predeploy {
+	predeploy, _ := lookup("ProxyAdmin")
+	return predeploy
+}
+
+// BaseFeeVaultPredeploy returns the BaseFeeVault predeploy.
+func BaseFeeVaultPredeploy() *Predeploy {
+	predeploy, _ := lookup("BaseFeeVault")
+	return predeploy
+}
+
+// L1FeeVaultPredeploy returns the L1FeeVault predeploy.
+func L1FeeVaultPredeploy() *Predeploy {
+	predeploy, _ := lookup("L1FeeVault")
+	return predeploy
+}
+
+// SchemaRegistryPredeploy returns the SchemaRegistry predeploy.
+func SchemaRegistryPredeploy() *Predeploy {
+	predeploy, _ := lookup("SchemaRegistry")
+	return predeploy
+}
+
+// EASPredeploy returns the EAS predeploy.
+func EASPredeploy() *Predeploy {
+	predeploy, _ := lookup("EAS")
+	return predeploy
+}
+
+// Create2DeployerPredeploy returns the Create2Deployer predeploy.
+func Create2DeployerPredeploy() *Predeploy {
+	predeploy, _ := lookup("Create2Deployer")
+	return predeploy
+}
+
+// L2ForkSchedulePredeploy returns the L2ForkSchedule predeploy.
+func L2ForkSchedulePredeploy() *Predeploy {
+	predeploy, _ := lookup("L2ForkSchedule")
+	return predeploy
+}
+
+// L2GasTokenPredeploy returns the L2GasToken predeploy.
+func L2GasTokenPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2GasToken")
+	return predeploy
+}
+
+// OasysGasFreeAllowlistPredeploy returns the OasysGasFreeAllowlist predeploy.
+func OasysGasFreeAllowlistPredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysGasFreeAllowlist")
+	return predeploy
+}
+
+// OasysGovernanceParamsPredeploy returns the OasysGovernanceParams predeploy.
+func OasysGovernanceParamsPredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysGovernanceParams")
+	return predeploy
+}
+
+// OasysBlockRewardSplitterPredeploy returns the OasysBlockRewardSplitter predeploy.
+func OasysBlockRewardSplitterPredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysBlockRewardSplitter")
+	return predeploy
+}
+
+// OasysPrecompileRegistryPredeploy returns the OasysPrecompileRegistry predeploy.
+func OasysPrecompileRegistryPredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysPrecompileRegistry")
+	return predeploy
+}
+
+// L2SequencerInfoPredeploy returns the L2SequencerInfo predeploy.
+func L2SequencerInfoPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2SequencerInfo")
+	return predeploy
+}
+
+// L2FaucetPredeploy returns the L2Faucet predeploy.
+func L2FaucetPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2Faucet")
+	return predeploy
+}
+
+// ProxyAdminUpgradeLogPredeploy returns the ProxyAdminUpgradeLog predeploy.
+func ProxyAdminUpgradeLogPredeploy() *Predeploy {
+	predeploy, _ := lookup("ProxyAdminUpgradeLog")
+	return predeploy
+}
+
+// OasysPriceOraclePredeploy returns the OasysPriceOracle predeploy.
+func OasysPriceOraclePredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysPriceOracle")
+	return predeploy
+}
+
+// OasysBridgeRateLimiterPredeploy returns the OasysBridgeRateLimiter predeploy.
+func OasysBridgeRateLimiterPredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysBridgeRateLimiter")
+	return predeploy
+}
+
+// L2DisputeInfoPredeploy returns the L2DisputeInfo predeploy.
+func L2DisputeInfoPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2DisputeInfo")
+	return predeploy
+}
+
+// OasysAddressBlocklistPredeploy returns the OasysAddressBlocklist predeploy.
+func OasysAddressBlocklistPredeploy() *Predeploy {
+	predeploy, _ := lookup("OasysAddressBlocklist")
+	return predeploy
+}
+
+// L2ChainInfoPredeploy returns the L2ChainInfo predeploy.
+func L2ChainInfoPredeploy() *Predeploy {
+	predeploy, _ := lookup("L2ChainInfo")
+	return predeploy
+}
+
+// Multicall3Predeploy returns the Multicall3 predeploy.
+func Multicall3Predeploy() *Predeploy {
+	predeploy, _ := lookup("Multicall3")
+	return predeploy
+}
@@ -0,0 +1,56 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessors(t *testing.T) {
+	accessors := map[string]struct {
+		get  func() *Predeploy
+		addr common.Address
+	}{
+		"L2ToL1MessagePasser":           {L2ToL1MessagePasserPredeploy, L2ToL1MessagePasserAddr},
+		"DeployerWhitelist":             {DeployerWhitelistPredeploy, DeployerWhitelistAddr},
+		"WETH9":                         {WETH9Predeploy, WETH9Addr},
+		"L2CrossDomainMessenger":        {L2CrossDomainMessengerPredeploy, L2CrossDomainMessengerAddr},
+		"L2StandardBridge":              {L2StandardBridgePredeploy, L2StandardBridgeAddr},
+		"SequencerFeeVault":             {SequencerFeeVaultPredeploy, SequencerFeeVaultAddr},
+		"OptimismMintableERC20Factory":  {OptimismMintableERC20FactoryPredeploy, OptimismMintableERC20FactoryAddr},
+		"L1BlockNumber":                 {L1BlockNumberPredeploy, L1BlockNumberAddr},
+		"GasPriceOracle":                {GasPriceOraclePredeploy, GasPriceOracleAddr},
+		"L1Block":                       {L1BlockPredeploy, L1BlockAddr},
+		"GovernanceToken":               {GovernanceTokenPredeploy, GovernanceTokenAddr},
+		"LegacyMessagePasser":           {LegacyMessagePasserPredeploy, LegacyMessagePasserAddr},
+		"OasysL2ERC721Bridge":           {OasysL2ERC721BridgePredeploy, OasysL2ERC721BridgeAddr},
+		"OptimismMintableERC721Factory": {OptimismMintableERC721FactoryPredeploy, OptimismMintableERC721FactoryAddr},
+		"ProxyAdmin":                    {ProxyAdminPredeploy, ProxyAdminAddr},
+		"BaseFeeVault":                  {BaseFeeVaultPredeploy, BaseFeeVaultAddr},
+		"L1FeeVault":                    {L1FeeVaultPredeploy, L1FeeVaultAddr},
+		"SchemaRegistry":                {SchemaRegistryPredeploy, SchemaRegistryAddr},
+		"EAS":                           {EASPredeploy, EASAddr},
+		"Create2Deployer":               {Create2DeployerPredeploy, Create2DeployerAddr},
+		"L2ForkSchedule":                {L2ForkSchedulePredeploy, L2ForkScheduleAddr},
+		"L2GasToken":                    {L2GasTokenPredeploy, L2GasTokenAddr},
+		"OasysGasFreeAllowlist":         {OasysGasFreeAllowlistPredeploy, OasysGasFreeAllowlistAddr},
+		"OasysGovernanceParams":         {OasysGovernanceParamsPredeploy, OasysGovernanceParamsAddr},
+		"OasysBlockRewardSplitter":      {OasysBlockRewardSplitterPredeploy, OasysBlockRewardSplitterAddr},
+		"OasysPrecompileRegistry":       {OasysPrecompileRegistryPredeploy, OasysPrecompileRegistryAddr},
+		"L2SequencerInfo":               {L2SequencerInfoPredeploy, L2SequencerInfoAddr},
+		"L2Faucet":                      {L2FaucetPredeploy, L2FaucetAddr},
+		"ProxyAdminUpgradeLog":          {ProxyAdminUpgradeLogPredeploy, ProxyAdminUpgradeLogAddr},
+		"OasysPriceOracle":              {OasysPriceOraclePredeploy, OasysPriceOracleAddr},
+		"OasysBridgeRateLimiter":        {OasysBridgeRateLimiterPredeploy, OasysBridgeRateLimiterAddr},
+		"L2DisputeInfo":                 {L2DisputeInfoPredeploy, L2DisputeInfoAddr},
+		"OasysAddressBlocklist":         {OasysAddressBlocklistPredeploy, OasysAddressBlocklistAddr},
+		"L2ChainInfo":                   {L2ChainInfoPredeploy, L2ChainInfoAddr},
+		"Multicall3":                    {Multicall3Predeploy, Multicall3Addr},
+	}
+	require.Len(t, accessors, len(Predeploys))
+	for name, accessor := range accessors {
+		require.Same(t, Predeploys[name], accessor.get(), name)
+		require.Equal(t, accessor.addr, accessor.get().Address, name)
+	}
+}
@@ -1,6 +1,9 @@
 package predeploys
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // TODO - we should get a single toml yaml or json file source of truth in @eth-optimism/bedrock package
 // This needs to be kept in sync with @eth-optimism/contracts-ts/wagmi.config.ts which also specifies this
@@ -26,9 +29,31 @@ const (
 	SchemaRegistry                = "0x4200000000000000000000000000000000000020"
 	EAS                           = "0x4200000000000000000000000000000000000021"
 	Create2Deployer               = "0x13b0D85CcB8bf860b6b79AF3029fCA081AE9beF2"
+	Multicall3                    = "0xcA11bde05977b3631167028862bE2a173976CA11"
+	L2ForkSchedule                = "0x4200000000000000000000000000000000000030"
+	L2GasToken                    = "0x4200000000000000000000000000000000000031"
+	L2SequencerInfo               = "0x4200000000000000000000000000000000000032"
+	L2Faucet                      = "0x4200000000000000000000000000000000000033"
+	ProxyAdminUpgradeLog          = "0x4200000000000000000000000000000000000034"
+	L2DisputeInfo                 = "0x4200000000000000000000000000000000000035"
+	L2ChainInfo                   = "0x4200000000000000000000000000000000000036"
 
 	// Oasys' L2 ERC721 Bridge was released before OPStack and has a different address.
 	OasysL2ERC721Bridge = "0x6200000000000000000000000000000000000001"
+	// Allowlist of contracts that accept gas-free transactions on Oasys verses.
+	OasysGasFreeAllowlist = "0x6200000000000000000000000000000000000002"
+	// Quorum and proposal threshold of the governance of Oasys verses.
+	OasysGovernanceParams = "0x6200000000000000000000000000000000000003"
+	// Splits block rewards between recipients on Oasys verses.
+	OasysBlockRewardSplitter = "0x6200000000000000000000000000000000000004"
+	// Registry of the custom precompiles of Oasys verses and their gas costs.
+	OasysPrecompileRegistry = "0x6200000000000000000000000000000000000005"
+	// Aggregator of the price feeds bundled with Oasys verses.
+	OasysPriceOracle = "0x6200000000000000000000000000000000000006"
+	// Rate limiter in front of the L2StandardBridge of Oasys verses.
+	OasysBridgeRateLimiter = "0x6200000000000000000000000000000000000007"
+	// Blocklist of addresses the bridge refuses to serve on Oasys verses.
+	OasysAddressBlocklist = "0x6200000000000000000000000000000000000008"
 )
 
 var (
@@ -54,47 +79,262 @@ var (
 	SchemaRegistryAddr                = common.HexToAddress(SchemaRegistry)
 	EASAddr                           = common.HexToAddress(EAS)
 	Create2DeployerAddr               = common.HexToAddress(Create2Deployer)
+	Multicall3Addr                    = common.HexToAddress(Multicall3)
+	L2ForkScheduleAddr                = common.HexToAddress(L2ForkSchedule)
+	L2GasTokenAddr                    = common.HexToAddress(L2GasToken)
+	L2SequencerInfoAddr               = common.HexToAddress(L2SequencerInfo)
+	L2FaucetAddr                      = common.HexToAddress(L2Faucet)
+	ProxyAdminUpgradeLogAddr          = common.HexToAddress(ProxyAdminUpgradeLog)
+	L2DisputeInfoAddr                 = common.HexToAddress(L2DisputeInfo)
+	L2ChainInfoAddr                   = common.HexToAddress(L2ChainInfo)
+	OasysGasFreeAllowlistAddr         = common.HexToAddress(OasysGasFreeAllowlist)
+	OasysGovernanceParamsAddr         = common.HexToAddress(OasysGovernanceParams)
+	OasysBlockRewardSplitterAddr      = common.HexToAddress(OasysBlockRewardSplitter)
+	OasysPrecompileRegistryAddr       = common.HexToAddress(OasysPrecompileRegistry)
+	OasysPriceOracleAddr              = common.HexToAddress(OasysPriceOracle)
+	OasysBridgeRateLimiterAddr        = common.HexToAddress(OasysBridgeRateLimiter)
+	OasysAddressBlocklistAddr         = common.HexToAddress(OasysAddressBlocklist)
 
 	Predeploys          = make(map[string]*Predeploy)
 	PredeploysByAddress = make(map[common.Address]*Predeploy)
 )
 
 func init() {
-	Predeploys["L2ToL1MessagePasser"] = &Predeploy{Address: L2ToL1MessagePasserAddr}
-	Predeploys["DeployerWhitelist"] = &Predeploy{Address: DeployerWhitelistAddr}
-	Predeploys["WETH9"] = &Predeploy{Address: WETH9Addr, ProxyDisabled: true}
-	Predeploys["L2CrossDomainMessenger"] = &Predeploy{Address: L2CrossDomainMessengerAddr}
-	Predeploys["L2StandardBridge"] = &Predeploy{Address: L2StandardBridgeAddr}
-	Predeploys["SequencerFeeVault"] = &Predeploy{Address: SequencerFeeVaultAddr}
-	Predeploys["OptimismMintableERC20Factory"] = &Predeploy{Address: OptimismMintableERC20FactoryAddr}
-	Predeploys["L1BlockNumber"] = &Predeploy{Address: L1BlockNumberAddr}
-	Predeploys["GasPriceOracle"] = &Predeploy{Address: GasPriceOracleAddr}
-	Predeploys["L1Block"] = &Predeploy{Address: L1BlockAddr}
+	Predeploys["L2ToL1MessagePasser"] = &Predeploy{
+		Address:              L2ToL1MessagePasserAddr,
+		ABI:                  lazyABI(bindings.L2ToL1MessagePasserMetaData),
+		Version:              "1.1.0",
+		StorageLayoutVersion: "1.1.0",
+	}
+	Predeploys["DeployerWhitelist"] = &Predeploy{Address: DeployerWhitelistAddr, ABI: lazyABI(bindings.DeployerWhitelistMetaData), Version: "1.1.0"}
+	Predeploys["WETH9"] = &Predeploy{
+		Address:       WETH9Addr,
+		ProxyDisabled: true,
+		ABI:           lazyABI(bindings.WETH9MetaData),
+		InitStorage:   WETH9InitStorage,
+		ConfigFields:  []string{"WrappedNativeName", "WrappedNativeSymbol"},
+	}
+	Predeploys["L2CrossDomainMessenger"] = &Predeploy{
+		Address:              L2CrossDomainMessengerAddr,
+		ABI:                  lazyABI(bindings.L2CrossDomainMessengerMetaData),
+		InitCalldata:         packInit(bindings.L2CrossDomainMessengerMetaData),
+		InitGas:              200_000,
+		UpgradeDelay:         BridgeUpgradeDelay,
+		Version:              "1.7.0",
+		StorageLayoutVersion: "1.7.0",
+	}
+	Predeploys["L2StandardBridge"] = &Predeploy{
+		Address:              L2StandardBridgeAddr,
+		ABI:                  lazyABI(bindings.L2StandardBridgeMetaData),
+		UpgradeDelay:         BridgeUpgradeDelay,
+		Version:              "1.5.0",
+		StorageLayoutVersion: "1.5.0",
+	}
+	Predeploys["SequencerFeeVault"] = &Predeploy{Address: SequencerFeeVaultAddr, ABI: lazyABI(bindings.SequencerFeeVaultMetaData), Version: "1.4.1"}
+	Predeploys["OptimismMintableERC20Factory"] = &Predeploy{Address: OptimismMintableERC20FactoryAddr, ABI: lazyABI(bindings.OptimismMintableERC20FactoryMetaData), Version: "1.8.0"}
+	Predeploys["L1BlockNumber"] = &Predeploy{Address: L1BlockNumberAddr, ABI: lazyABI(bindings.L1BlockNumberMetaData), Version: "1.1.0"}
+	Predeploys["GasPriceOracle"] = &Predeploy{
+		Address:              GasPriceOracleAddr,
+		ABI:                  lazyABI(bindings.GasPriceOracleMetaData),
+		Migrate:              gasPriceOracleMigrate,
+		Version:              "1.1.0",
+		StorageLayoutVersion: "1.1.0",
+		ConfigFields:         []string{"ForkSchedule"},
+	}
+	Predeploys["L1Block"] = &Predeploy{
+		Address:              L1BlockAddr,
+		ABI:                  lazyABI(bindings.L1BlockMetaData),
+		Version:              "1.1.0",
+		StorageLayoutVersion: "1.1.0",
+	}
 	Predeploys["GovernanceToken"] = &Predeploy{
 		Address:       GovernanceTokenAddr,
 		ProxyDisabled: true,
+		ABI:           lazyABI(bindings.GovernanceTokenMetaData),
 		Enabled: func(config DeployConfig) bool {
 			return config.GovernanceEnabled()
 		},
+		ConfigFields: []string{"GovernanceEnabled"},
 	}
-	Predeploys["LegacyMessagePasser"] = &Predeploy{Address: LegacyMessagePasserAddr}
-	Predeploys["OasysL2ERC721Bridge"] = &Predeploy{Address: OasysL2ERC721BridgeAddr}
-	Predeploys["OptimismMintableERC721Factory"] = &Predeploy{Address: OptimismMintableERC721FactoryAddr}
-	Predeploys["ProxyAdmin"] = &Predeploy{Address: ProxyAdminAddr}
-	Predeploys["BaseFeeVault"] = &Predeploy{Address: BaseFeeVaultAddr}
-	Predeploys["L1FeeVault"] = &Predeploy{Address: L1FeeVaultAddr}
-	Predeploys["SchemaRegistry"] = &Predeploy{Address: SchemaRegistryAddr}
-	Predeploys["EAS"] = &Predeploy{Address: EASAddr}
+	Predeploys["LegacyMessagePasser"] = &Predeploy{Address: LegacyMessagePasserAddr, ABI: lazyABI(bindings.LegacyMessagePasserMetaData), Version: "1.1.0"}
+	Predeploys["OasysL2ERC721Bridge"] = &Predeploy{
+		Address:           OasysL2ERC721BridgeAddr,
+		ABI:               lazyABI(bindings.OasysL2ERC721BridgeMetaData),
+		MutuallyExclusive: []string{"OPStackL2ERC721Bridge"},
+		UpgradeDelay:      BridgeUpgradeDelay,
+	}
+	Predeploys["OptimismMintableERC721Factory"] = &Predeploy{Address: OptimismMintableERC721FactoryAddr, ABI: lazyABI(bindings.OptimismMintableERC721FactoryMetaData), Version: "1.4.0"}
+	Predeploys["ProxyAdmin"] = &Predeploy{Address: ProxyAdminAddr, ABI: lazyABI(bindings.ProxyAdminMetaData)}
+	Predeploys["BaseFeeVault"] = &Predeploy{
+		Address:      BaseFeeVaultAddr,
+		ABI:          lazyABI(bindings.BaseFeeVaultMetaData),
+		InitStorage:  BaseFeeVaultInitStorage,
+		Version:      "1.4.1",
+		ConfigFields: []string{"FeeVaultRecipient", "FeeVaultWithdrawalNetwork", "FeeVaultMinimum", "ForkSchedule"},
+	}
+	Predeploys["L1FeeVault"] = &Predeploy{
+		Address:      L1FeeVaultAddr,
+		ABI:          lazyABI(bindings.L1FeeVaultMetaData),
+		InitStorage:  L1FeeVaultInitStorage,
+		Version:      "1.4.1",
+		ConfigFields: []string{"FeeVaultRecipient", "FeeVaultWithdrawalNetwork", "ForkSchedule"},
+	}
+	Predeploys["SchemaRegistry"] = &Predeploy{Address: SchemaRegistryAddr, ABI: lazyABI(bindings.SchemaRegistryMetaData), Version: "1.3.0"}
+	Predeploys["EAS"] = &Predeploy{Address: EASAddr, ABI: lazyABI(bindings.EASMetaData), Version: "1.4.0"}
 	Predeploys["Create2Deployer"] = &Predeploy{
 		Address:       Create2DeployerAddr,
 		ProxyDisabled: true,
+		ActivationTime: func(config DeployConfig) *uint64 {
+			return config.ForkSchedule().CanyonTime
+		},
+		ConfigFields: []string{"ForkSchedule"},
+	}
+	Predeploys["Multicall3"] = &Predeploy{
+		Address:       Multicall3Addr,
+		ProxyDisabled: true,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[Multicall3Config](config)
+			return ok && c.Multicall3Enabled()
+		},
+		ConfigFields: []string{"Multicall3Enabled"},
+	}
+	Predeploys["L2ForkSchedule"] = &Predeploy{
+		Address: L2ForkScheduleAddr,
+		Enabled: func(config DeployConfig) bool {
+			return config.ForkSchedule().Published
+		},
+		InitStorage:  forkScheduleStorage,
+		ConfigFields: []string{"ForkSchedule"},
+	}
+	Predeploys["L2GasToken"] = &Predeploy{
+		Address: L2GasTokenAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[CustomGasTokenConfig](config)
+			return ok && c.CustomGasTokenEnabled()
+		},
+		InitStorage:  gasTokenStorage,
+		ConfigFields: []string{"CustomGasTokenEnabled", "GasTokenAddress"},
+	}
+	Predeploys["L2SequencerInfo"] = &Predeploy{
+		Address: L2SequencerInfoAddr,
 		Enabled: func(config DeployConfig) bool {
-			canyonTime := config.CanyonTime(0)
-			return canyonTime != nil && *canyonTime == 0
+			c, ok := configAs[SequencerInfoConfig](config)
+			return ok && c.SequencerInfoEnabled()
 		},
+		InitStorage:  sequencerInfoStorage,
+		ConfigFields: []string{"SequencerInfoEnabled", "P2PSequencerAddress"},
+	}
+	Predeploys["L2Faucet"] = &Predeploy{
+		Address: L2FaucetAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[FaucetConfig](config)
+			return ok && c.FaucetEnabled()
+		},
+		InitStorage:  faucetStorage,
+		ConfigFields: []string{"FaucetEnabled", "FaucetDripAmount", "FaucetOwner"},
+	}
+	Predeploys["ProxyAdminUpgradeLog"] = &Predeploy{
+		Address: ProxyAdminUpgradeLogAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[UpgradeLogConfig](config)
+			return ok && c.UpgradeLogEnabled()
+		},
+		InitStorage:  UpgradeLogInitStorage,
+		ConfigFields: []string{"UpgradeLogEnabled"},
+	}
+	Predeploys["L2DisputeInfo"] = &Predeploy{
+		Address: L2DisputeInfoAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[DisputeGameConfig](config)
+			return ok && c.DisputeInfoEnabled()
+		},
+		InitStorage:  disputeInfoStorage,
+		ConfigFields: []string{"DisputeInfoEnabled", "DisputeGameFactory"},
+	}
+	Predeploys["L2ChainInfo"] = &Predeploy{
+		Address: L2ChainInfoAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[ChainInfoConfig](config)
+			return ok && c.ChainInfoEnabled()
+		},
+		InitStorage:  chainInfoStorage,
+		ConfigFields: []string{"ChainInfoEnabled", "L2GenesisTime", "L1ChainID"},
+	}
+	Predeploys["OasysGasFreeAllowlist"] = &Predeploy{
+		Address: OasysGasFreeAllowlistAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[GasFreeConfig](config)
+			return ok && c.GasFreeEnabled()
+		},
+		InitStorage:  gasFreeAllowlistStorage,
+		ConfigFields: []string{"GasFreeEnabled", "GasFreeAllowlist"},
+	}
+	Predeploys["OasysGovernanceParams"] = &Predeploy{
+		Address: OasysGovernanceParamsAddr,
+		Enabled: func(config DeployConfig) bool {
+			_, ok := configAs[GovernanceParamsConfig](config)
+			return ok && config.GovernanceEnabled()
+		},
+		InitStorage:  governanceParamsStorage,
+		ConfigFields: []string{"GovernanceEnabled", "GovernanceQuorum", "ProposalThreshold"},
+	}
+	Predeploys["OasysBlockRewardSplitter"] = &Predeploy{
+		Address: OasysBlockRewardSplitterAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[BlockRewardSplitConfig](config)
+			return ok && c.BlockRewardSplitEnabled()
+		},
+		InitStorage:  blockRewardSplitStorage,
+		ConfigFields: []string{"BlockRewardSplitEnabled", "BlockRewardSplits"},
+	}
+	Predeploys["OasysPrecompileRegistry"] = &Predeploy{
+		Address: OasysPrecompileRegistryAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[CustomPrecompileConfig](config)
+			return ok && c.CustomPrecompilesEnabled()
+		},
+		InitStorage:  precompileRegistryStorage,
+		ConfigFields: []string{"CustomPrecompilesEnabled", "CustomPrecompiles"},
+	}
+	Predeploys["OasysPriceOracle"] = &Predeploy{
+		Address: OasysPriceOracleAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[PriceOracleConfig](config)
+			return ok && c.PriceOracleEnabled()
+		},
+		InitStorage:  priceOracleStorage,
+		ConfigFields: []string{"PriceOracleEnabled", "PriceFeeds"},
+	}
+	Predeploys["OasysBridgeRateLimiter"] = &Predeploy{
+		Address: OasysBridgeRateLimiterAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[BridgeRateLimitConfig](config)
+			return ok && c.BridgeRateLimitEnabled()
+		},
+		InitStorage:  bridgeRateLimiterStorage,
+		ConfigFields: []string{"BridgeRateLimitEnabled", "BridgeRateLimitCap"},
+	}
+	Predeploys["OasysAddressBlocklist"] = &Predeploy{
+		Address: OasysAddressBlocklistAddr,
+		Enabled: func(config DeployConfig) bool {
+			c, ok := configAs[BlocklistConfig](config)
+			return ok && c.BlocklistEnabled()
+		},
+		InitStorage:  blocklistStorage,
+		ConfigFields: []string{"BlocklistEnabled", "BlockedAddresses"},
 	}
 
-	for _, predeploy := range Predeploys {
+	for name, predeploy := range Predeploys {
+		if err := checkEnabledField(name, predeploy); err != nil {
+			panic(err)
+		}
 		PredeploysByAddress[predeploy.Address] = predeploy
 	}
+
+	if err := CheckImplementationCollisions(); err != nil {
+		panic(err)
+	}
+	if err := CheckPrecompileCollisions(); err != nil {
+		panic(err)
+	}
 }
@@ -38,3 +38,41 @@ func TestL1BlockSlots(t *testing.T) {
 	require.Equal(t, types.ScalarSlot, scalarSlot)
 	require.Equal(t, types.L1BaseFeeSlot, l1BaseFeeSlot)
 }
+
+// featurePredeploys are the predeploys registered ahead of their contracts.
+var featurePredeploys = map[string]common.Address{
+	"L2ForkSchedule":           L2ForkScheduleAddr,
+	"L2GasToken":               L2GasTokenAddr,
+	"L2SequencerInfo":          L2SequencerInfoAddr,
+	"L2Faucet":                 L2FaucetAddr,
+	"ProxyAdminUpgradeLog":     ProxyAdminUpgradeLogAddr,
+	"L2DisputeInfo":            L2DisputeInfoAddr,
+	"L2ChainInfo":              L2ChainInfoAddr,
+	"OasysGasFreeAllowlist":    OasysGasFreeAllowlistAddr,
+	"OasysGovernanceParams":    OasysGovernanceParamsAddr,
+	"OasysBlockRewardSplitter": OasysBlockRewardSplitterAddr,
+	"OasysPrecompileRegistry":  OasysPrecompileRegistryAddr,
+	"OasysPriceOracle":         OasysPriceOracleAddr,
+	"OasysBridgeRateLimiter":   OasysBridgeRateLimiterAddr,
+	"OasysAddressBlocklist":    OasysAddressBlocklistAddr,
+}
+
+func TestFeaturePredeploysRegistered(t *testing.T) {
+	for name, addr := range featurePredeploys {
+		predeploy, ok := Predeploys[name]
+		require.True(t, ok, name)
+		require.Equal(t, addr, predeploy.Address, name)
+		require.Same(t, predeploy, PredeploysByAddress[addr], name)
+		_, err := ResolveDeployedBytecode(name)
+		require.ErrorContains(t, err, "no bytecode", name)
+	}
+	require.Contains(t, Predeploys, "Multicall3")
+}
+
+func TestValidateBytecode(t *testing.T) {
+	require.NoError(t, ValidateBytecode(&testDeployConfig{}))
+	require.NoError(t, ValidateBytecode(&testDeployConfig{governance: true, canyonTime: u64(0)}))
+
+	err := ValidateBytecode(&forkDeployConfig{scheduleEnabled: true})
+	require.ErrorContains(t, err, "predeploy L2ForkSchedule has no bytecode")
+}
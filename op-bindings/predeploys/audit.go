@@ -0,0 +1,112 @@
+package predeploys
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// AuditCheck is the outcome of a single check of FullAudit. Err is nil when
+// the check passed.
+type AuditCheck struct {
+	Name string
+	Err  error
+}
+
+// Passed reports whether the check passed.
+func (c AuditCheck) Passed() bool {
+	return c.Err == nil
+}
+
+// AuditReport is the outcome of FullAudit, one entry per check in the order
+// they were run.
+type AuditReport struct {
+	Checks []AuditCheck
+}
+
+// Passed reports whether every check of the report passed.
+func (r AuditReport) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// Failed returns the checks of the report that did not pass.
+func (r AuditReport) Failed() []AuditCheck {
+	var failed []AuditCheck
+	for _, check := range r.Checks {
+		if !check.Passed() {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// FullAudit runs the predeploy validators against the registry, the config
+// and a genesis alloc, and reports the outcome of each check:
+//
+//   - namespace: no predeploy sits in the precompile range.
+//   - duplicate: no two proxied predeploys share an implementation address.
+//   - enablement: mandatory predeploys are active and mutually exclusive
+//     ones are not active together.
+//   - proxy-wiring: every active predeploy has code in the alloc, and
+//     proxies point at an implementation with code and at the ProxyAdmin.
+//   - code-size: the code of every active predeploy and of its
+//     implementation fits within the EIP-170 limit.
+//
+// Every check is run even if an earlier one fails.
+func FullAudit(config DeployConfig, alloc map[common.Address]core.GenesisAccount) AuditReport {
+	return AuditReport{Checks: []AuditCheck{
+		{Name: "namespace", Err: CheckPrecompileCollisions()},
+		{Name: "duplicate", Err: CheckImplementationCollisions()},
+		{Name: "enablement", Err: errors.Join(ValidateMandatory(config), ValidateMutualExclusion(config))},
+		{Name: "proxy-wiring", Err: auditProxyWiring(config, alloc)},
+		{Name: "code-size", Err: auditCodeSize(config, alloc)},
+	}}
+}
+
+// auditProxyWiring checks that the active predeploys are present in the
+// alloc and that their proxies are administered by the ProxyAdmin.
+func auditProxyWiring(config DeployConfig, alloc map[common.Address]core.GenesisAccount) error {
+	if err := AssertGenesisComplete(alloc, config); err != nil {
+		return err
+	}
+	active := ActivePredeploys(config)
+	for _, name := range VerifyProxyAdminWiring(alloc) {
+		if _, ok := active[name]; ok {
+			return fmt.Errorf("predeploy %s is not administered by the ProxyAdmin", name)
+		}
+	}
+	return nil
+}
+
+// auditCodeSize checks that the code of the active predeploys, and of the
+// implementations of the proxied ones, does not exceed params.MaxCodeSize.
+func auditCodeSize(config DeployConfig, alloc map[common.Address]core.GenesisAccount) error {
+	active := ActivePredeploys(config)
+	names := make([]string, 0, len(active))
+	for name := range active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		predeploy := active[name]
+		addrs := []common.Address{predeploy.Address}
+		if !predeploy.ProxyDisabled {
+			impl, err := ImplementationAddress(predeploy.Address)
+			if err != nil {
+				return fmt.Errorf("predeploy %s: %w", name, err)
+			}
+			addrs = append(addrs, impl)
+		}
+		for _, addr := range addrs {
+			if size := len(alloc[addr].Code); size > params.MaxCodeSize {
+				return fmt.Errorf("predeploy %s: code at %s is %d bytes, exceeding %d", name, addr, size, params.MaxCodeSize)
+			}
+		}
+	}
+	return nil
+}
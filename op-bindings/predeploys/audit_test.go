@@ -0,0 +1,34 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullAudit(t *testing.T) {
+	config := &testDeployConfig{}
+	report := FullAudit(config, TestFixture())
+	require.True(t, report.Passed())
+	require.Len(t, report.Checks, 5)
+
+	alloc := TestFixture()
+	impl, err := ImplementationAddress(L2StandardBridgeAddr)
+	require.NoError(t, err)
+	account := alloc[impl]
+	account.Code = make([]byte, params.MaxCodeSize+1)
+	alloc[impl] = account
+
+	report = FullAudit(config, alloc)
+	require.False(t, report.Passed())
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	require.Equal(t, "code-size", failed[0].Name)
+	require.ErrorContains(t, failed[0].Err, "L2StandardBridge")
+	for _, check := range report.Checks {
+		if check.Name != "code-size" {
+			require.True(t, check.Passed(), check.Name)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package predeploys
+
+import "github.com/ethereum/go-ethereum/core/types"
+
+// MatchesBloom reports whether any active predeploy address may be present
+// in the given logs bloom. False positives are possible, false negatives
+// are not.
+func MatchesBloom(bloom types.Bloom, config DeployConfig) bool {
+	for _, predeploy := range ActivePredeploys(config) {
+		if types.BloomLookup(bloom, predeploy.Address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesBloom(t *testing.T) {
+	config := &testDeployConfig{}
+	require.False(t, MatchesBloom(types.Bloom{}, config))
+
+	var bloom types.Bloom
+	bloom.Add(L2StandardBridgeAddr.Bytes())
+	require.True(t, MatchesBloom(bloom, config))
+
+	var other types.Bloom
+	other.Add(common.HexToAddress("0x1234").Bytes())
+	require.False(t, MatchesBloom(other, config))
+}
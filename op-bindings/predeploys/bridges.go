@@ -0,0 +1,24 @@
+package predeploys
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BridgeUpgradeDelay is the upgrade timelock delay of the bridges and messengers.
+const BridgeUpgradeDelay = 7 * 24 * time.Hour
+
+// ActiveERC721Bridge returns the address of the L2 ERC721 bridge in use.
+// Oasys shipped its own L2 ERC721 bridge before the OP Stack introduced one,
+// so the OP Stack address is reserved but never deployed, and the Oasys
+// bridge is the one withdrawal tooling must target.
+func ActiveERC721Bridge() common.Address {
+	return OasysL2ERC721BridgeAddr
+}
+
+// IsReservedOPStackBridge reports whether addr is the reserved, unused
+// OP Stack L2 ERC721 bridge address.
+func IsReservedOPStackBridge(addr common.Address) bool {
+	return addr == OPStackL2ERC721BridgeAddr
+}
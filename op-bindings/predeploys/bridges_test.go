@@ -0,0 +1,31 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveERC721Bridge(t *testing.T) {
+	require.Equal(t, OasysL2ERC721BridgeAddr, ActiveERC721Bridge())
+	require.Equal(t, L2ERC721BridgeAddr, ActiveERC721Bridge())
+	require.Contains(t, PredeploysByAddress, ActiveERC721Bridge())
+	require.False(t, IsReservedOPStackBridge(ActiveERC721Bridge()))
+}
+
+func TestIsReservedOPStackBridge(t *testing.T) {
+	require.True(t, IsReservedOPStackBridge(OPStackL2ERC721BridgeAddr))
+	require.NotContains(t, PredeploysByAddress, OPStackL2ERC721BridgeAddr)
+}
+
+func TestValidateMutualExclusion(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{}
+	require.NoError(t, ValidateMutualExclusion(config))
+
+	require.NoError(t, Register("OPStackL2ERC721Bridge", &Predeploy{Address: OPStackL2ERC721BridgeAddr}))
+	require.ErrorContains(t, ValidateMutualExclusion(config), "OasysL2ERC721Bridge and OPStackL2ERC721Bridge")
+
+	require.NoError(t, SetEnabledOverride("OasysL2ERC721Bridge", false))
+	require.NoError(t, ValidateMutualExclusion(config))
+}
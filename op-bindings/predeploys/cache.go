@@ -0,0 +1,211 @@
+package predeploys
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultActiveCacheSize is the default number of configs whose active
+// predeploy sets are memoized by CachedActive.
+const DefaultActiveCacheSize = 16
+
+// activeCacheEntry is an active predeploy set memoized by CachedActive,
+// along with the registry generation it was computed at.
+type activeCacheEntry struct {
+	generation uint64
+	active     map[string]*Predeploy
+}
+
+var (
+	activeCacheMu  sync.Mutex
+	activeCache, _ = lru.New[common.Hash, activeCacheEntry](DefaultActiveCacheSize)
+)
+
+// CachedActive is like ActivePredeploys, but memoizes the result per config,
+// keyed on RegistryHash. Mutating a config therefore yields a fresh result,
+// and configs that cannot be hashed are never cached. Results computed
+// before a change of the registry or its overrides are not reused.
+func CachedActive(config DeployConfig) map[string]*Predeploy {
+	key, err := RegistryHash(config)
+	if err != nil {
+		return ActivePredeploys(config)
+	}
+	registryMu.RLock()
+	generation := registryGeneration
+	registryMu.RUnlock()
+
+	activeCacheMu.Lock()
+	entry, ok := activeCache.Get(key)
+	activeCacheMu.Unlock()
+	if ok && entry.generation == generation {
+		return copyPredeploys(entry.active)
+	}
+
+	registryMu.RLock()
+	generation = registryGeneration
+	active := activePredeploysLocked(config, evaluationTime(config))
+	registryMu.RUnlock()
+
+	activeCacheMu.Lock()
+	activeCache.Add(key, activeCacheEntry{generation: generation, active: active})
+	activeCacheMu.Unlock()
+	return copyPredeploys(active)
+}
+
+// SetActiveCacheSize sets the maximum number of configs memoized by
+// CachedActive and clears the cache.
+func SetActiveCacheSize(size int) error {
+	cache, err := lru.New[common.Hash, activeCacheEntry](size)
+	if err != nil {
+		return fmt.Errorf("invalid cache size %d: %w", size, err)
+	}
+	activeCacheMu.Lock()
+	defer activeCacheMu.Unlock()
+	activeCache = cache
+	return nil
+}
+
+// maxHashDepth bounds the nesting RegistryHash follows, so that cyclic
+// configs fail instead of recursing forever.
+const maxHashDepth = 64
+
+// RegistryHash returns a hash of the concrete type and content of the
+// config, following pointers, for keying results derived from it. Configs
+// with equal content hash equally regardless of their identity. It fails
+// for configs holding functions, channels or unsafe pointers, and for
+// configs nested deeper than supported, e.g. because they are cyclic.
+func RegistryHash(config DeployConfig) (common.Hash, error) {
+	var buf bytes.Buffer
+	if err := hashValue(&buf, reflect.ValueOf(config), true, 0); err != nil {
+		return common.Hash{}, fmt.Errorf("cannot hash deploy config %T: %w", config, err)
+	}
+	// SHA-256 is hardware accelerated and several times faster than Keccak
+	// for the size of typical deploy configs.
+	return sha256.Sum256(buf.Bytes()), nil
+}
+
+var byteType = reflect.TypeOf(byte(0))
+
+// hashValue writes an unambiguous encoding of the value to the buffer. The
+// type of the value is only written when dynamic, i.e. for the config itself
+// and for interface values, since the static type of the enclosing value
+// determines the types of its elements and fields.
+func hashValue(buf *bytes.Buffer, v reflect.Value, dynamic bool, depth int) error {
+	if depth > maxHashDepth {
+		return fmt.Errorf("nested deeper than %d", maxHashDepth)
+	}
+	if !v.IsValid() {
+		buf.WriteByte(0)
+		return nil
+	}
+	if dynamic {
+		writeString(buf, v.Type().String())
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(buf, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(buf, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(buf, math.Float64bits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint(buf, math.Float64bits(real(v.Complex())))
+		writeUint(buf, math.Float64bits(imag(v.Complex())))
+	case reflect.String:
+		writeString(buf, v.String())
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteByte(0)
+			return nil
+		}
+		buf.WriteByte(1)
+		return hashValue(buf, v.Elem(), v.Kind() == reflect.Interface, depth+1)
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			buf.WriteByte(0)
+			return nil
+		}
+		buf.WriteByte(1)
+		writeUint(buf, uint64(v.Len()))
+		if v.Type().Elem() == byteType {
+			// Bytes are written as is rather than widened to 8 bytes each.
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			buf.Write(b)
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := hashValue(buf, v.Index(i), false, depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if err := hashValue(buf, v.Field(i), false, depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			buf.WriteByte(0)
+			return nil
+		}
+		buf.WriteByte(1)
+		entries := make([][]byte, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			var entry bytes.Buffer
+			if err := hashValue(&entry, iter.Key(), false, depth+1); err != nil {
+				return err
+			}
+			if err := hashValue(&entry, iter.Value(), false, depth+1); err != nil {
+				return err
+			}
+			entries = append(entries, entry.Bytes())
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return bytes.Compare(entries[i], entries[j]) < 0
+		})
+		writeUint(buf, uint64(len(entries)))
+		for _, entry := range entries {
+			writeString(buf, string(entry))
+		}
+	default:
+		return fmt.Errorf("unhashable %s value", v.Kind())
+	}
+	return nil
+}
+
+func writeUint(buf *bytes.Buffer, x uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], x)
+	buf.Write(b[:])
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUint(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func copyPredeploys(predeploys map[string]*Predeploy) map[string]*Predeploy {
+	out := make(map[string]*Predeploy, len(predeploys))
+	for name, predeploy := range predeploys {
+		out[name] = predeploy
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+package predeploys
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedActive(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{governance: true}
+	require.Equal(t, ActivePredeploys(config), CachedActive(config))
+	require.Equal(t, ActivePredeploys(config), CachedActive(config))
+
+	// Mutating the result does not affect later cache hits.
+	delete(CachedActive(config), "L1Block")
+	require.Contains(t, CachedActive(config), "L1Block")
+
+	// Overrides invalidate the cache.
+	require.NoError(t, SetEnabledOverride("GovernanceToken", false))
+	require.NotContains(t, CachedActive(config), "GovernanceToken")
+	require.Equal(t, ActivePredeploys(config), CachedActive(config))
+}
+
+func TestCachedActiveMutatedConfig(t *testing.T) {
+	config := &testDeployConfig{}
+	require.NotContains(t, CachedActive(config), "GovernanceToken")
+	config.governance = true
+	require.Contains(t, CachedActive(config), "GovernanceToken")
+
+	// Distinct configs with equal content share a cache entry.
+	a, b := &testDeployConfig{canyonTime: u64(0)}, &testDeployConfig{canyonTime: u64(0)}
+	hashA, err := RegistryHash(a)
+	require.NoError(t, err)
+	hashB, err := RegistryHash(b)
+	require.NoError(t, err)
+	require.Equal(t, hashA, hashB)
+	*b.canyonTime = 1
+	hashB, err = RegistryHash(b)
+	require.NoError(t, err)
+	require.NotEqual(t, hashA, hashB)
+	require.NotContains(t, CachedActive(b), "Create2Deployer")
+}
+
+// anyDeployConfig holds an arbitrary value, which may not be comparable.
+type anyDeployConfig struct {
+	*testDeployConfig
+	extra any
+}
+
+func TestCachedActiveUncomparable(t *testing.T) {
+	config := anyDeployConfig{testDeployConfig: &testDeployConfig{}, extra: []string{"a"}}
+	require.Equal(t, ActivePredeploys(config), CachedActive(config))
+
+	// Values of different dynamic types hash differently.
+	hashInt, err := RegistryHash(anyDeployConfig{testDeployConfig: &testDeployConfig{}, extra: int(1)})
+	require.NoError(t, err)
+	hashUint, err := RegistryHash(anyDeployConfig{testDeployConfig: &testDeployConfig{}, extra: uint(1)})
+	require.NoError(t, err)
+	require.NotEqual(t, hashInt, hashUint)
+
+	config.extra = func() {}
+	_, err = RegistryHash(config)
+	require.Error(t, err)
+	require.Equal(t, ActivePredeploys(config), CachedActive(config))
+}
+
+func TestCachedActiveConcurrentMutation(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{governance: true}
+	errs := make(chan error, 4*50*2)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				CachedActive(config)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				addr := common.BigToAddress(common.Big256)
+				addr[0], addr[1], addr[2] = 0x42, byte(i), byte(j)
+				errs <- Register(fmt.Sprintf("Test%d_%d", i, j), &Predeploy{Address: addr})
+				errs <- SetEnabledOverride("GovernanceToken", j%2 == 0)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, ActivePredeploys(config), CachedActive(config))
+}
+
+func TestSetActiveCacheSize(t *testing.T) {
+	require.Error(t, SetActiveCacheSize(0))
+	require.NoError(t, SetActiveCacheSize(1))
+	t.Cleanup(func() { require.NoError(t, SetActiveCacheSize(DefaultActiveCacheSize)) })
+
+	a, b := &testDeployConfig{}, &testDeployConfig{governance: true}
+	require.Equal(t, ActivePredeploys(a), CachedActive(a))
+	require.Equal(t, ActivePredeploys(b), CachedActive(b))
+	require.Equal(t, ActivePredeploys(a), CachedActive(a))
+}
+
+func BenchmarkActivePredeploys(b *testing.B) {
+	config := &testDeployConfig{governance: true}
+	for i := 0; i < b.N; i++ {
+		ActivePredeploys(config)
+	}
+}
+
+func BenchmarkCachedActive(b *testing.B) {
+	config := &testDeployConfig{governance: true}
+	for i := 0; i < b.N; i++ {
+		CachedActive(config)
+	}
+}
@@ -0,0 +1,36 @@
+package predeploys
+
+import "github.com/ethereum/go-ethereum/common"
+
+// CalldataReferencesPredeploy returns the active predeploy addresses found in
+// the ABI-encoded arguments of the calldata, in order of first appearance.
+// The calldata after the 4 byte selector is scanned as 32 byte words, and a
+// word references a predeploy if it holds its address left-padded with
+// zeros. This is a heuristic: any argument that happens to equal a predeploy
+// address is reported, and addresses in packed encodings are missed.
+func CalldataReferencesPredeploy(data []byte, config DeployConfig) []common.Address {
+	if len(data) < 4 {
+		return nil
+	}
+	active := ActivePredeploys(config)
+	byAddress := make(map[common.Address]bool, len(active))
+	for _, predeploy := range active {
+		byAddress[predeploy.Address] = true
+	}
+
+	var found []common.Address
+	seen := make(map[common.Address]bool)
+	padding := make([]byte, common.HashLength-common.AddressLength)
+	for offset := 4; offset+common.HashLength <= len(data); offset += common.HashLength {
+		word := data[offset : offset+common.HashLength]
+		if string(word[:len(padding)]) != string(padding) {
+			continue
+		}
+		addr := common.BytesToAddress(word[len(padding):])
+		if byAddress[addr] && !seen[addr] {
+			seen[addr] = true
+			found = append(found, addr)
+		}
+	}
+	return found
+}
@@ -0,0 +1,23 @@
+package predeploys
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalldataReferencesPredeploy(t *testing.T) {
+	config := &testDeployConfig{}
+	data := []byte{0xa9, 0x05, 0x9c, 0xbb}
+	data = append(data, common.LeftPadBytes(L2StandardBridgeAddr.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(100).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(L2StandardBridgeAddr.Bytes(), 32)...)
+	// Disabled predeploys are not reported.
+	data = append(data, common.LeftPadBytes(GovernanceTokenAddr.Bytes(), 32)...)
+	require.Equal(t, []common.Address{L2StandardBridgeAddr}, CalldataReferencesPredeploy(data, config))
+
+	require.Empty(t, CalldataReferencesPredeploy(data[:4], config))
+	require.Empty(t, CalldataReferencesPredeploy(nil, config))
+}
@@ -0,0 +1,115 @@
+package predeploys
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// devGenesisGasLimit is the block gas limit of genesis built by DevGenesis.
+const devGenesisGasLimit = 30_000_000
+
+// devBytecode returns the embedded deployed bytecode of the contract, or
+// stub code if none is embedded.
+func devBytecode(name string) []byte {
+	if code, err := bindings.GetDeployedBytecode(name); err == nil {
+		return code
+	}
+	return stubCode
+}
+
+// DevGenesis builds a genesis for local development chains that contains
+// the active predeploys. Contracts use their embedded deployed bytecode when
+// available and stub code otherwise; immutables are not set, so the result
+// is only suitable for tests and dev chains.
+func DevGenesis(config DeployConfig) (*core.Genesis, error) {
+	alloc, err := predeployAlloc(config, func(name string) ([]byte, error) {
+		return devBytecode(name), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &core.Genesis{
+		Config:     params.AllDevChainProtocolChanges,
+		GasLimit:   devGenesisGasLimit,
+		Difficulty: common.Big0,
+		Alloc:      alloc,
+	}, nil
+}
+
+// codeFromMap returns a code lookup for predeployAlloc that fails for names
+// missing from the map.
+func codeFromMap(codes map[string][]byte) func(name string) ([]byte, error) {
+	return func(name string) ([]byte, error) {
+		code, ok := codes[name]
+		if !ok {
+			return nil, fmt.Errorf("no code for %s", name)
+		}
+		return code, nil
+	}
+}
+
+// GenesisBlockHash returns the hash of the genesis block built from the
+// header template and a state holding only the active predeploys, whose code
+// is looked up by name and the code of proxies as "Proxy". The state root of
+// the template is replaced, every other field is kept.
+func GenesisBlockHash(config DeployConfig, code map[string][]byte, header *types.Header) (common.Hash, error) {
+	alloc, err := predeployAlloc(config, codeFromMap(code))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	db, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	for addr, account := range alloc {
+		db.SetBalance(addr, account.Balance)
+		db.SetCode(addr, account.Code)
+		for key, value := range account.Storage {
+			db.SetState(addr, key, value)
+		}
+	}
+	genesis := types.CopyHeader(header)
+	genesis.Root = db.IntermediateRoot(false)
+	return genesis.Hash(), nil
+}
+
+// predeployAlloc builds the accounts of the active predeploys, looking up
+// their code by name and the code of proxies as "Proxy". Proxied predeploys
+// get a proxy account holding the expected storage and an implementation
+// account; the others hold their code and storage directly.
+func predeployAlloc(config DeployConfig, code func(name string) ([]byte, error)) (core.GenesisAlloc, error) {
+	alloc := make(core.GenesisAlloc)
+	for name, predeploy := range ActivePredeploys(config) {
+		storage, err := expectedStorage(predeploy, config)
+		if err != nil {
+			return nil, fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		predeployCode, err := code(name)
+		if err != nil {
+			return nil, fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		balance := genesisBalance(predeploy, config)
+		if predeploy.ProxyDisabled {
+			alloc[predeploy.Address] = core.GenesisAccount{Code: predeployCode, Storage: storage, Balance: balance}
+			continue
+		}
+		impl, err := ImplementationAddress(predeploy.Address)
+		if err != nil {
+			return nil, fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		proxyCode, err := code("Proxy")
+		if err != nil {
+			return nil, fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		alloc[impl] = core.GenesisAccount{Code: predeployCode, Balance: common.Big0}
+		alloc[predeploy.Address] = core.GenesisAccount{Code: proxyCode, Storage: storage, Balance: balance}
+	}
+	return alloc, nil
+}
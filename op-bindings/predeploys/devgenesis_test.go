@@ -0,0 +1,57 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevGenesis(t *testing.T) {
+	config := &testDeployConfig{}
+	genesis, err := DevGenesis(config)
+	require.NoError(t, err)
+
+	for _, name := range MandatoryPredeploys() {
+		account, ok := genesis.Alloc[Predeploys[name].Address]
+		require.True(t, ok, name)
+		require.NotEmpty(t, account.Code, name)
+	}
+	require.NotContains(t, genesis.Alloc, GovernanceTokenAddr)
+	require.NoError(t, AssertGenesisComplete(genesis.Alloc, config))
+	require.NoError(t, AssertGenesisHash(genesis, config))
+	require.Empty(t, VerifyProxyAdminWiring(genesis.Alloc))
+
+	// The genesis block can be built from it.
+	require.NotNil(t, genesis.ToBlock())
+}
+
+func TestGenesisBlockHash(t *testing.T) {
+	config := &testDeployConfig{}
+	code := map[string][]byte{"Proxy": {0xaa}}
+	for name := range ActivePredeploys(config) {
+		code[name] = []byte{0x01}
+	}
+	alloc, err := predeployAlloc(config, codeFromMap(code))
+	require.NoError(t, err)
+	genesis := &core.Genesis{
+		Config:     params.AllDevChainProtocolChanges,
+		GasLimit:   30_000_000,
+		Difficulty: common.Big0,
+		Alloc:      alloc,
+	}
+	block := genesis.ToBlock()
+
+	hash, err := GenesisBlockHash(config, code, block.Header())
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), hash)
+	again, err := GenesisBlockHash(config, code, block.Header())
+	require.NoError(t, err)
+	require.Equal(t, hash, again)
+
+	delete(code, "Proxy")
+	_, err = GenesisBlockHash(config, code, block.Header())
+	require.ErrorContains(t, err, "no code for Proxy")
+}
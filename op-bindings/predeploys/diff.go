@@ -0,0 +1,43 @@
+package predeploys
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// governanceConfig overrides whether governance is enabled in a config.
+type governanceConfig struct {
+	DeployConfig
+	enabled bool
+}
+
+func (c *governanceConfig) GovernanceEnabled() bool {
+	return c.enabled
+}
+
+func (c *governanceConfig) Unwrap() DeployConfig {
+	return c.DeployConfig
+}
+
+// GovernanceEnableDelta returns the addresses of the predeploys that become
+// active when governance is turned on for the config, sorted by address.
+func GovernanceEnableDelta(config DeployConfig) []common.Address {
+	before := ActivePredeploys(&governanceConfig{DeployConfig: config, enabled: false})
+	after := ActivePredeploys(&governanceConfig{DeployConfig: config, enabled: true})
+	var added []common.Address
+	for name, predeploy := range after {
+		if _, ok := before[name]; !ok {
+			added = append(added, predeploy.Address)
+		}
+	}
+	sortAddresses(added)
+	return added
+}
+
+func sortAddresses(addrs []common.Address) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+}
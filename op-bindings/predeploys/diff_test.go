@@ -0,0 +1,19 @@
+package predeploys
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceEnableDelta(t *testing.T) {
+	require.Equal(t, []common.Address{GovernanceTokenAddr}, GovernanceEnableDelta(&testDeployConfig{}))
+	require.Equal(t, []common.Address{GovernanceTokenAddr}, GovernanceEnableDelta(&testDeployConfig{governance: true}))
+
+	// Predeploys gated on an optional config interface are seen through the
+	// governance override.
+	config := &governanceParamsDeployConfig{quorum: big.NewInt(400), threshold: big.NewInt(1e18)}
+	require.Equal(t, []common.Address{GovernanceTokenAddr, OasysGovernanceParamsAddr}, GovernanceEnableDelta(config))
+}
@@ -0,0 +1,84 @@
+package predeploys
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	addressArrayType, _ = abi.NewType("address[]", "", nil)
+	bytes32Type, _      = abi.NewType("bytes32", "", nil)
+
+	// l1CommitmentArguments is the ABI layout of L1Commitment.
+	l1CommitmentArguments = abi.Arguments{{Type: addressArrayType}, {Type: bytes32Type}}
+)
+
+// canonicalEntry is the RLP representation of a single predeploy
+// in the canonical encoding.
+type canonicalEntry struct {
+	Name    string
+	Address common.Address
+	Proxied bool
+}
+
+// CanonicalEncoding returns a deterministic RLP encoding of the active
+// predeploys as a list of (name, address, proxied) tuples sorted by address.
+// It can be hashed to commit to the predeploy layout of a chain.
+func CanonicalEncoding(config DeployConfig) []byte {
+	var entries []canonicalEntry
+	for name, predeploy := range ActivePredeploys(config) {
+		entries = append(entries, canonicalEntry{
+			Name:    name,
+			Address: predeploy.Address,
+			Proxied: !predeploy.ProxyDisabled,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].Address[:], entries[j].Address[:]) < 0
+	})
+
+	// Encoding a list of strings, addresses and bools cannot fail.
+	enc, err := rlp.EncodeToBytes(entries)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// L1Commitment returns the ABI encoding of (address[], bytes32) committing to
+// the active predeploys, for storage in an L1 contract, along with its
+// keccak256 hash. The addresses are sorted and the bytes32 is the hash of
+// CanonicalEncoding, which also commits to the names and proxy status.
+func L1Commitment(config DeployConfig) ([]byte, common.Hash) {
+	active := ActivePredeploys(config)
+	addrs := make([]common.Address, 0, len(active))
+	for _, predeploy := range active {
+		addrs = append(addrs, predeploy.Address)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+
+	// Packing an address array and a hash cannot fail.
+	enc, err := l1CommitmentArguments.Pack(addrs, crypto.Keccak256Hash(CanonicalEncoding(config)))
+	if err != nil {
+		panic(err)
+	}
+	return enc, crypto.Keccak256Hash(enc)
+}
+
+// VerifyL1Commitment recomputes the L1Commitment hash of the active
+// predeploys and returns an error if it differs from the expected one, e.g.
+// the hash anchored on L1.
+func VerifyL1Commitment(config DeployConfig, expected common.Hash) error {
+	if _, hash := L1Commitment(config); hash != expected {
+		return fmt.Errorf("predeploy commitment mismatch: computed %s, expected %s", hash, expected)
+	}
+	return nil
+}
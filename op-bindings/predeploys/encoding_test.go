@@ -0,0 +1,50 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalEncoding(t *testing.T) {
+	config := &testDeployConfig{governance: true, canyonTime: u64(0)}
+	enc := CanonicalEncoding(config)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, enc, CanonicalEncoding(config))
+	}
+	require.NotEqual(t, enc, CanonicalEncoding(&testDeployConfig{}))
+	require.Equal(t, "0x360a6c2f9b9be478c5156ee07b1d6d97db00ee9f2f3f01d59f54df4351e5c4f1", crypto.Keccak256Hash(enc).Hex())
+}
+
+func TestL1Commitment(t *testing.T) {
+	config := &testDeployConfig{governance: true, canyonTime: u64(0)}
+	enc, hash := L1Commitment(config)
+	require.Equal(t, crypto.Keccak256Hash(enc), hash)
+	for i := 0; i < 10; i++ {
+		again, againHash := L1Commitment(config)
+		require.Equal(t, enc, again)
+		require.Equal(t, hash, againHash)
+	}
+
+	out, err := l1CommitmentArguments.Unpack(enc)
+	require.NoError(t, err)
+	addrs := out[0].([]common.Address)
+	require.Len(t, addrs, len(ActivePredeploys(config)))
+	require.Contains(t, addrs, L2StandardBridgeAddr)
+	require.Equal(t, crypto.Keccak256Hash(CanonicalEncoding(config)), common.Hash(out[1].([32]byte)))
+
+	_, other := L1Commitment(&testDeployConfig{})
+	require.NotEqual(t, hash, other)
+}
+
+func TestVerifyL1Commitment(t *testing.T) {
+	config := &testDeployConfig{governance: true, canyonTime: u64(0)}
+	_, hash := L1Commitment(config)
+	require.NoError(t, VerifyL1Commitment(config, hash))
+
+	_, other := L1Commitment(&testDeployConfig{})
+	require.ErrorContains(t, VerifyL1Commitment(config, other), "mismatch")
+	require.Error(t, VerifyL1Commitment(config, common.Hash{}))
+}
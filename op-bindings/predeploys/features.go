@@ -0,0 +1,187 @@
+package predeploys
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CustomGasTokenConfig is implemented by deploy configs of chains that use
+// an ERC20 token as their gas token.
+type CustomGasTokenConfig interface {
+	CustomGasTokenEnabled() bool
+	GasTokenAddress() common.Address
+}
+
+// GasTokenSlot is the L2GasToken slot holding the address of the gas token.
+var GasTokenSlot = common.BigToHash(common.Big0)
+
+// gasTokenStorage seeds the L2GasToken with the address of the gas token.
+func gasTokenStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	if c, ok := configAs[CustomGasTokenConfig](config); ok {
+		storage[GasTokenSlot] = common.BytesToHash(c.GasTokenAddress().Bytes())
+	}
+	return storage
+}
+
+// SequencerInfoConfig is implemented by deploy configs that publish the
+// address of the sequencer, the unsafe block signer, to L2 contracts.
+type SequencerInfoConfig interface {
+	SequencerInfoEnabled() bool
+	P2PSequencerAddress() common.Address
+}
+
+// SequencerAddressSlot is the L2SequencerInfo slot holding the sequencer address.
+var SequencerAddressSlot = common.BigToHash(common.Big0)
+
+// sequencerInfoStorage seeds the L2SequencerInfo with the sequencer address.
+func sequencerInfoStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	if c, ok := configAs[SequencerInfoConfig](config); ok {
+		storage[SequencerAddressSlot] = common.BytesToHash(c.P2PSequencerAddress().Bytes())
+	}
+	return storage
+}
+
+// DisputeGameConfig is implemented by deploy configs of chains with fault
+// proofs that publish the address of the L1 DisputeGameFactory to L2 contracts.
+type DisputeGameConfig interface {
+	DisputeInfoEnabled() bool
+	DisputeGameFactory() common.Address
+}
+
+// DisputeGameFactorySlot is the L2DisputeInfo slot holding the address of
+// the L1 DisputeGameFactory.
+var DisputeGameFactorySlot = common.BigToHash(common.Big0)
+
+// disputeInfoStorage seeds the L2DisputeInfo with the L1 DisputeGameFactory.
+func disputeInfoStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	if c, ok := configAs[DisputeGameConfig](config); ok {
+		storage[DisputeGameFactorySlot] = common.BytesToHash(c.DisputeGameFactory().Bytes())
+	}
+	return storage
+}
+
+// ChainInfoConfig is implemented by deploy configs that publish the genesis
+// timestamp of the L2 to L2 contracts.
+type ChainInfoConfig interface {
+	ChainInfoEnabled() bool
+	L2GenesisTime() uint64
+}
+
+// L1ChainIDConfig is implemented by deploy configs that publish the chain ID
+// of the L1 to L2 contracts through the L2ChainInfo.
+type L1ChainIDConfig interface {
+	L1ChainID() *big.Int
+}
+
+// The storage layout of the L2ChainInfo, which is dedicated to chain
+// metadata, so that the slots do not collide with the layout of another
+// contract.
+var (
+	// genesisTimeSlot is the L2ChainInfo slot holding the L2 genesis timestamp.
+	genesisTimeSlot = common.BigToHash(common.Big0)
+	// l1ChainIDSlot is the L2ChainInfo slot holding the L1 chain ID.
+	l1ChainIDSlot = common.BigToHash(common.Big1)
+)
+
+// GenesisTimeSlot returns the L2ChainInfo slot holding the L2 genesis timestamp.
+func GenesisTimeSlot() common.Hash {
+	return genesisTimeSlot
+}
+
+// L1ChainIDSlot returns the L2ChainInfo slot holding the chain ID of the L1.
+func L1ChainIDSlot() common.Hash {
+	return l1ChainIDSlot
+}
+
+// chainInfoStorage seeds the L2ChainInfo with the L2 genesis timestamp, and
+// with the L1 chain ID if the config implements L1ChainIDConfig.
+func chainInfoStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	if c, ok := configAs[ChainInfoConfig](config); ok {
+		storage[genesisTimeSlot] = common.BigToHash(new(big.Int).SetUint64(c.L2GenesisTime()))
+	}
+	if c, ok := configAs[L1ChainIDConfig](config); ok && c.L1ChainID() != nil {
+		storage[l1ChainIDSlot] = common.BigToHash(c.L1ChainID())
+	}
+	return storage
+}
+
+// FaucetConfig is implemented by deploy configs of testnets that ship a
+// faucet. It must never be enabled on mainnet configs.
+type FaucetConfig interface {
+	FaucetEnabled() bool
+	FaucetDripAmount() *big.Int
+	FaucetOwner() common.Address
+}
+
+var (
+	// FaucetDripAmountSlot is the L2Faucet slot holding the drip amount in wei.
+	FaucetDripAmountSlot = common.BigToHash(common.Big0)
+	// FaucetOwnerSlot is the L2Faucet slot holding the owner.
+	FaucetOwnerSlot = common.BigToHash(common.Big1)
+)
+
+// faucetStorage seeds the L2Faucet with its drip amount and owner.
+func faucetStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[FaucetConfig](config)
+	if !ok {
+		return storage
+	}
+	if amount := c.FaucetDripAmount(); amount != nil {
+		storage[FaucetDripAmountSlot] = common.BigToHash(amount)
+	}
+	storage[FaucetOwnerSlot] = common.BytesToHash(c.FaucetOwner().Bytes())
+	return storage
+}
+
+// UpgradeLogConfig is implemented by deploy configs that record the
+// upgrades performed through the ProxyAdmin on-chain.
+type UpgradeLogConfig interface {
+	UpgradeLogEnabled() bool
+}
+
+// UpgradeLogLengthSlot is the ProxyAdminUpgradeLog slot holding the length
+// of the dynamic array of upgrade records.
+var UpgradeLogLengthSlot = common.BigToHash(common.Big0)
+
+// UpgradeLogInitStorage seeds the ProxyAdminUpgradeLog with an empty log.
+func UpgradeLogInitStorage(config DeployConfig) map[common.Hash]common.Hash {
+	return map[common.Hash]common.Hash{
+		UpgradeLogLengthSlot: {},
+	}
+}
+
+// WrappedNativeConfig is implemented by deploy configs of chains whose
+// wrapped native token, WETH9, is not named after Ether.
+type WrappedNativeConfig interface {
+	WrappedNativeName() string
+	WrappedNativeSymbol() string
+}
+
+const (
+	// DefaultWrappedNativeName is the name of WETH9 by default.
+	DefaultWrappedNativeName = "Wrapped Ether"
+	// DefaultWrappedNativeSymbol is the symbol of WETH9 by default.
+	DefaultWrappedNativeSymbol = "WETH"
+)
+
+// WETH9InitStorage seeds the name and symbol of WETH9, which it keeps in
+// the strings at slots 0 and 1 rather than in immutables, so they can be
+// set in the genesis even though WETH9 is not proxied. Configs that do not
+// implement WrappedNativeConfig get the default Ether names.
+func WETH9InitStorage(config DeployConfig) map[common.Hash]common.Hash {
+	name, symbol := DefaultWrappedNativeName, DefaultWrappedNativeSymbol
+	if c, ok := configAs[WrappedNativeConfig](config); ok {
+		name, symbol = c.WrappedNativeName(), c.WrappedNativeSymbol()
+	}
+	storage := stringStorage(0, name)
+	for key, value := range stringStorage(1, symbol) {
+		storage[key] = value
+	}
+	return storage
+}
@@ -0,0 +1,248 @@
+package predeploys
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type gasTokenDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	token   common.Address
+}
+
+func (c *gasTokenDeployConfig) CustomGasTokenEnabled() bool {
+	return c.enabled
+}
+
+func (c *gasTokenDeployConfig) GasTokenAddress() common.Address {
+	return c.token
+}
+
+func TestL2GasToken(t *testing.T) {
+	predeploy := Predeploys["L2GasToken"]
+	require.Equal(t, predeploy, PredeploysByAddress[L2GasTokenAddr])
+	require.False(t, predeploy.ProxyDisabled)
+
+	token := common.HexToAddress("0x1234")
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "L2GasToken")
+	require.NotContains(t, ActivePredeploys(&gasTokenDeployConfig{token: token}), "L2GasToken")
+
+	config := &gasTokenDeployConfig{enabled: true, token: token}
+	require.Contains(t, ActivePredeploys(config), "L2GasToken")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy L2GasToken has no bytecode")
+	require.Equal(t, map[common.Hash]common.Hash{
+		GasTokenSlot: common.HexToHash("0x1234"),
+	}, predeploy.InitStorage(config))
+}
+
+type sequencerInfoDeployConfig struct {
+	testDeployConfig
+	enabled   bool
+	sequencer common.Address
+}
+
+func (c *sequencerInfoDeployConfig) SequencerInfoEnabled() bool {
+	return c.enabled
+}
+
+func (c *sequencerInfoDeployConfig) P2PSequencerAddress() common.Address {
+	return c.sequencer
+}
+
+func TestL2SequencerInfo(t *testing.T) {
+	predeploy := Predeploys["L2SequencerInfo"]
+	require.Equal(t, predeploy, PredeploysByAddress[L2SequencerInfoAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "L2SequencerInfo")
+
+	require.NotContains(t, ActivePredeploys(&sequencerInfoDeployConfig{sequencer: common.HexToAddress("0x5678")}), "L2SequencerInfo")
+	config := &sequencerInfoDeployConfig{enabled: true, sequencer: common.HexToAddress("0x5678")}
+	require.Contains(t, ActivePredeploys(config), "L2SequencerInfo")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy L2SequencerInfo has no bytecode")
+	require.Equal(t, map[common.Hash]common.Hash{
+		SequencerAddressSlot: common.HexToHash("0x5678"),
+	}, predeploy.InitStorage(config))
+}
+
+type disputeGameDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	factory common.Address
+}
+
+func (c *disputeGameDeployConfig) DisputeInfoEnabled() bool {
+	return c.enabled
+}
+
+func (c *disputeGameDeployConfig) DisputeGameFactory() common.Address {
+	return c.factory
+}
+
+func TestL2DisputeInfo(t *testing.T) {
+	predeploy := Predeploys["L2DisputeInfo"]
+	require.Equal(t, predeploy, PredeploysByAddress[L2DisputeInfoAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "L2DisputeInfo")
+
+	require.NotContains(t, ActivePredeploys(&disputeGameDeployConfig{factory: common.HexToAddress("0xd15f")}), "L2DisputeInfo")
+	config := &disputeGameDeployConfig{enabled: true, factory: common.HexToAddress("0xd15f")}
+	require.Contains(t, ActivePredeploys(config), "L2DisputeInfo")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy L2DisputeInfo has no bytecode")
+	require.Equal(t, map[common.Hash]common.Hash{
+		DisputeGameFactorySlot: common.HexToHash("0xd15f"),
+	}, predeploy.InitStorage(config))
+}
+
+type chainInfoDeployConfig struct {
+	testDeployConfig
+	enabled     bool
+	genesisTime uint64
+}
+
+func (c *chainInfoDeployConfig) ChainInfoEnabled() bool {
+	return c.enabled
+}
+
+func (c *chainInfoDeployConfig) L2GenesisTime() uint64 {
+	return c.genesisTime
+}
+
+func TestL2ChainInfo(t *testing.T) {
+	predeploy := Predeploys["L2ChainInfo"]
+	require.Equal(t, predeploy, PredeploysByAddress[L2ChainInfoAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "L2ChainInfo")
+
+	require.NotContains(t, ActivePredeploys(&chainInfoDeployConfig{genesisTime: 1_700_000_000}), "L2ChainInfo")
+	config := &chainInfoDeployConfig{enabled: true, genesisTime: 1_700_000_000}
+	require.Contains(t, ActivePredeploys(config), "L2ChainInfo")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy L2ChainInfo has no bytecode")
+	storage := predeploy.InitStorage(config)
+	require.Len(t, storage, 1)
+	require.Equal(t, uint64(1_700_000_000), storage[GenesisTimeSlot()].Big().Uint64())
+
+	withL1ChainID := &l1ChainIDDeployConfig{chainInfoDeployConfig: *config, chainID: big.NewInt(248)}
+	active := ActivePredeploys(withL1ChainID)
+	require.Same(t, predeploy, active["L2ChainInfo"])
+	require.ErrorContains(t, ValidateBytecode(withL1ChainID), "predeploy L2ChainInfo has no bytecode")
+	storage = active["L2ChainInfo"].InitStorage(withL1ChainID)
+	require.Len(t, storage, 2)
+	require.Equal(t, uint64(1_700_000_000), storage[GenesisTimeSlot()].Big().Uint64())
+	require.Equal(t, big.NewInt(248), storage[L1ChainIDSlot()].Big())
+	require.NotEqual(t, GenesisTimeSlot(), L1ChainIDSlot())
+
+	// The L1Block keeps its own layout and is not seeded with the L1 chain ID.
+	require.Nil(t, Predeploys["L1Block"].InitStorage)
+}
+
+type l1ChainIDDeployConfig struct {
+	chainInfoDeployConfig
+	chainID *big.Int
+}
+
+func (c *l1ChainIDDeployConfig) L1ChainID() *big.Int {
+	return c.chainID
+}
+
+type faucetDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	amount  *big.Int
+	owner   common.Address
+}
+
+func (c *faucetDeployConfig) FaucetEnabled() bool {
+	return c.enabled
+}
+
+func (c *faucetDeployConfig) FaucetDripAmount() *big.Int {
+	return c.amount
+}
+
+func (c *faucetDeployConfig) FaucetOwner() common.Address {
+	return c.owner
+}
+
+func TestL2Faucet(t *testing.T) {
+	predeploy := Predeploys["L2Faucet"]
+	require.Equal(t, predeploy, PredeploysByAddress[L2FaucetAddr])
+	require.False(t, predeploy.ProxyDisabled)
+
+	// Mainnet configs do not ship a faucet.
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "L2Faucet")
+	mainnet := &faucetDeployConfig{amount: big.NewInt(1e18)}
+	require.NotContains(t, ActivePredeploys(mainnet), "L2Faucet")
+
+	testnet := &faucetDeployConfig{enabled: true, amount: big.NewInt(1e18), owner: common.HexToAddress("0xabcd")}
+	require.Contains(t, ActivePredeploys(testnet), "L2Faucet")
+	require.ErrorContains(t, ValidateBytecode(testnet), "predeploy L2Faucet has no bytecode")
+	require.Equal(t, map[common.Hash]common.Hash{
+		FaucetDripAmountSlot: common.BigToHash(big.NewInt(1e18)),
+		FaucetOwnerSlot:      common.HexToHash("0xabcd"),
+	}, predeploy.InitStorage(testnet))
+}
+
+type upgradeLogDeployConfig struct {
+	testDeployConfig
+	enabled bool
+}
+
+func (c *upgradeLogDeployConfig) UpgradeLogEnabled() bool {
+	return c.enabled
+}
+
+func TestProxyAdminUpgradeLog(t *testing.T) {
+	predeploy := Predeploys["ProxyAdminUpgradeLog"]
+	require.Equal(t, predeploy, PredeploysByAddress[ProxyAdminUpgradeLogAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "ProxyAdminUpgradeLog")
+	require.NotContains(t, ActivePredeploys(&upgradeLogDeployConfig{}), "ProxyAdminUpgradeLog")
+
+	config := &upgradeLogDeployConfig{enabled: true}
+	require.Contains(t, ActivePredeploys(config), "ProxyAdminUpgradeLog")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy ProxyAdminUpgradeLog has no bytecode")
+	storage := UpgradeLogInitStorage(config)
+	require.Contains(t, storage, UpgradeLogLengthSlot)
+	require.Equal(t, common.Hash{}, storage[UpgradeLogLengthSlot])
+}
+
+type wrappedNativeDeployConfig struct {
+	testDeployConfig
+	name, symbol string
+}
+
+func (c *wrappedNativeDeployConfig) WrappedNativeName() string {
+	return c.name
+}
+
+func (c *wrappedNativeDeployConfig) WrappedNativeSymbol() string {
+	return c.symbol
+}
+
+func TestWETH9InitStorage(t *testing.T) {
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.BigToHash(common.Big0): common.HexToHash("0x577261707065642045746865720000000000000000000000000000000000001a"),
+		common.BigToHash(common.Big1): common.HexToHash("0x5745544800000000000000000000000000000000000000000000000000000008"),
+	}, WETH9InitStorage(&testDeployConfig{}))
+	require.Equal(t, WETH9InitStorage(&testDeployConfig{}), Predeploys["WETH9"].InitStorage(&testDeployConfig{}))
+
+	config := &wrappedNativeDeployConfig{name: "Wrapped OAS", symbol: "WOAS"}
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.BigToHash(common.Big0): common.HexToHash("0x57726170706564204f4153000000000000000000000000000000000000000016"),
+		common.BigToHash(common.Big1): common.HexToHash("0x574f415300000000000000000000000000000000000000000000000000000008"),
+	}, WETH9InitStorage(config))
+
+	// Names of 32 bytes or more are stored out of line.
+	config.name = "Wrapped Oasys Verse Native Token!"
+	storage := WETH9InitStorage(config)
+	require.Equal(t, common.BigToHash(big.NewInt(33*2+1)), storage[common.BigToHash(common.Big0)])
+	data := crypto.Keccak256Hash(common.BigToHash(common.Big0).Bytes()).Big()
+	require.Equal(t, common.BytesToHash([]byte("Wrapped Oasys Verse Native Token")), storage[common.BigToHash(data)])
+	require.Equal(t, common.HexToHash("0x2100000000000000000000000000000000000000000000000000000000000000"), storage[common.BigToHash(data.Add(data, common.Big1))])
+	require.Len(t, storage, 4)
+}
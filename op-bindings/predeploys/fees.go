@@ -0,0 +1,190 @@
+package predeploys
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeeVaultConfig is implemented by deploy configs that expose the
+// withdrawal settings of the fee vaults. Vaults are identified by the
+// predeploy name, e.g. "SequencerFeeVault".
+type FeeVaultConfig interface {
+	FeeVaultRecipient(vault string) common.Address
+	FeeVaultWithdrawalNetwork(vault string) WithdrawalNetwork
+}
+
+// WithdrawalNetwork is the network fee vaults withdraw to. Its values match
+// the WITHDRAWAL_NETWORK of the fee vault contracts.
+type WithdrawalNetwork uint8
+
+const (
+	WithdrawalNetworkL1 WithdrawalNetwork = 0
+	WithdrawalNetworkL2 WithdrawalNetwork = 1
+)
+
+// FeeFlowEdge describes fees accumulated in a vault being withdrawn to a recipient.
+type FeeFlowEdge struct {
+	Vault     string
+	From      common.Address
+	Recipient common.Address
+}
+
+// feeVaults are the names of the predeploys that accumulate protocol fees.
+var feeVaults = []string{"SequencerFeeVault", "BaseFeeVault", "L1FeeVault"}
+
+// FeeFlow describes how protocol fees are routed from the fee vaults to
+// their configured recipients. Recipients are left empty when the config
+// does not implement FeeVaultConfig.
+func FeeFlow(config DeployConfig) []FeeFlowEdge {
+	c, _ := configAs[FeeVaultConfig](config)
+	predeploys := registered()
+	edges := make([]FeeFlowEdge, 0, len(feeVaults))
+	for _, vault := range feeVaults {
+		edge := FeeFlowEdge{Vault: vault, From: predeploys[vault].Address}
+		if c != nil {
+			edge.Recipient = c.FeeVaultRecipient(vault)
+		}
+		edges = append(edges, edge)
+	}
+	return edges
+}
+
+// SequencerFeeVaultWithdrawalTarget returns the recipient of the
+// SequencerFeeVault and the network it is withdrawn to. It defaults to
+// the zero address on L1 when the config does not implement FeeVaultConfig.
+func SequencerFeeVaultWithdrawalTarget(config DeployConfig) (common.Address, WithdrawalNetwork) {
+	c, ok := configAs[FeeVaultConfig](config)
+	if !ok {
+		return common.Address{}, WithdrawalNetworkL1
+	}
+	return c.FeeVaultRecipient("SequencerFeeVault"), c.FeeVaultWithdrawalNetwork("SequencerFeeVault")
+}
+
+// SequencerFeeVaultMinimumConfig is implemented by deploy configs that set
+// the minimum withdrawal amount of the SequencerFeeVault.
+type SequencerFeeVaultMinimumConfig interface {
+	SequencerFeeVaultMinimum() *big.Int
+}
+
+// SequencerFeeVaultMinWithdrawal returns the MIN_WITHDRAWAL_AMOUNT immutable
+// of the SequencerFeeVault, in wei. It returns nil when the config does not
+// implement SequencerFeeVaultMinimumConfig.
+func SequencerFeeVaultMinWithdrawal(config DeployConfig) *big.Int {
+	c, ok := configAs[SequencerFeeVaultMinimumConfig](config)
+	if !ok {
+		return nil
+	}
+	return c.SequencerFeeVaultMinimum()
+}
+
+// FeeVaultImmutables are the withdrawal settings of a fee vault. The fee
+// vaults keep them as immutables set by their constructor, RECIPIENT,
+// MIN_WITHDRAWAL_AMOUNT and WITHDRAWAL_NETWORK.
+type FeeVaultImmutables struct {
+	Recipient common.Address
+	// MinWithdrawalAmount is in wei. It is nil when the config does not
+	// implement FeeVaultMinimumConfig.
+	MinWithdrawalAmount *big.Int
+	WithdrawalNetwork   WithdrawalNetwork
+}
+
+// L1FeeVaultImmutables returns the immutables of the L1FeeVault. It returns
+// false when the config does not implement FeeVaultConfig.
+func L1FeeVaultImmutables(config DeployConfig) (FeeVaultImmutables, bool) {
+	return feeVaultImmutables(config, "L1FeeVault")
+}
+
+var (
+	// L1FeeVaultRecipientSlot is the L1FeeVault slot seeded with the
+	// recipient. Post-Ecotone the withdrawal network is packed into the
+	// same slot, right above the 20 byte address.
+	L1FeeVaultRecipientSlot = common.BigToHash(common.Big1)
+	// L1FeeVaultWithdrawalNetworkSlot is the L1FeeVault slot seeded with the
+	// withdrawal network pre-Ecotone.
+	L1FeeVaultWithdrawalNetworkSlot = common.BigToHash(common.Big2)
+)
+
+// L1FeeVaultInitStorage returns the storage seeding the L1FeeVault with its
+// withdrawal settings. Pre-Ecotone the recipient and the withdrawal network
+// occupy a slot each; once Ecotone is active at the evaluation time of the
+// config, they are packed into L1FeeVaultRecipientSlot. Slot 0 holds
+// totalProcessed and is never seeded. It returns nil when the config does
+// not implement FeeVaultConfig.
+func L1FeeVaultInitStorage(config DeployConfig) map[common.Hash]common.Hash {
+	c, ok := configAs[FeeVaultConfig](config)
+	if !ok {
+		return nil
+	}
+	return feeVaultSettingsStorage(config, c, "L1FeeVault")
+}
+
+// feeVaultSettingsStorage returns the recipient and withdrawal network slots
+// of the fee vault, packed into a single slot once Ecotone is active.
+func feeVaultSettingsStorage(config DeployConfig, c FeeVaultConfig, vault string) map[common.Hash]common.Hash {
+	recipient := c.FeeVaultRecipient(vault)
+	network := c.FeeVaultWithdrawalNetwork(vault)
+	if !enabledAtOrAfter(ecotoneTime)(config) {
+		return map[common.Hash]common.Hash{
+			L1FeeVaultRecipientSlot:         common.BytesToHash(recipient.Bytes()),
+			L1FeeVaultWithdrawalNetworkSlot: common.BigToHash(big.NewInt(int64(network))),
+		}
+	}
+	packed := new(big.Int).Lsh(big.NewInt(int64(network)), common.AddressLength*8)
+	packed.Or(packed, new(big.Int).SetBytes(recipient.Bytes()))
+	return map[common.Hash]common.Hash{
+		L1FeeVaultRecipientSlot: common.BigToHash(packed),
+	}
+}
+
+// feeVaultImmutables returns the immutables of the named fee vault.
+func feeVaultImmutables(config DeployConfig, vault string) (FeeVaultImmutables, bool) {
+	c, ok := configAs[FeeVaultConfig](config)
+	if !ok {
+		return FeeVaultImmutables{}, false
+	}
+	immutables := FeeVaultImmutables{
+		Recipient:         c.FeeVaultRecipient(vault),
+		WithdrawalNetwork: c.FeeVaultWithdrawalNetwork(vault),
+	}
+	if m, ok := configAs[FeeVaultMinimumConfig](config); ok {
+		immutables.MinWithdrawalAmount = m.FeeVaultMinimum(vault)
+	}
+	return immutables, true
+}
+
+// FeeVaultMinimumConfig is implemented by deploy configs that expose the
+// minimum withdrawal amount of the fee vaults, identified by predeploy name.
+type FeeVaultMinimumConfig interface {
+	FeeVaultMinimum(vault string) *big.Int
+}
+
+// BaseFeeVaultImmutables returns the immutables of the BaseFeeVault. It
+// returns false when the config does not implement FeeVaultConfig.
+func BaseFeeVaultImmutables(config DeployConfig) (FeeVaultImmutables, bool) {
+	return feeVaultImmutables(config, "BaseFeeVault")
+}
+
+// BaseFeeVaultMinWithdrawalSlot is the BaseFeeVault slot seeded with the
+// minimum withdrawal amount in wei. It does not move at Ecotone.
+var BaseFeeVaultMinWithdrawalSlot = common.BigToHash(big.NewInt(3))
+
+// BaseFeeVaultInitStorage returns the storage seeding the BaseFeeVault with
+// its withdrawal settings. The recipient and the withdrawal network use the
+// slots of the L1FeeVault, including their Ecotone packing, and the minimum
+// withdrawal amount is stored at BaseFeeVaultMinWithdrawalSlot if the config
+// implements FeeVaultMinimumConfig. It returns nil when the config does not
+// implement FeeVaultConfig.
+func BaseFeeVaultInitStorage(config DeployConfig) map[common.Hash]common.Hash {
+	c, ok := configAs[FeeVaultConfig](config)
+	if !ok {
+		return nil
+	}
+	storage := feeVaultSettingsStorage(config, c, "BaseFeeVault")
+	if m, ok := configAs[FeeVaultMinimumConfig](config); ok {
+		if minimum := m.FeeVaultMinimum("BaseFeeVault"); minimum != nil {
+			storage[BaseFeeVaultMinWithdrawalSlot] = common.BigToHash(minimum)
+		}
+	}
+	return storage
+}
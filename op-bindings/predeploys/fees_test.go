@@ -0,0 +1,210 @@
+package predeploys
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type feeVaultDeployConfig struct {
+	testDeployConfig
+	recipients map[string]common.Address
+	networks   map[string]WithdrawalNetwork
+}
+
+func (c *feeVaultDeployConfig) FeeVaultRecipient(vault string) common.Address {
+	return c.recipients[vault]
+}
+
+func (c *feeVaultDeployConfig) FeeVaultWithdrawalNetwork(vault string) WithdrawalNetwork {
+	return c.networks[vault]
+}
+
+func TestFeeFlow(t *testing.T) {
+	config := &feeVaultDeployConfig{recipients: map[string]common.Address{
+		"SequencerFeeVault": common.HexToAddress("0x01"),
+		"BaseFeeVault":      common.HexToAddress("0x02"),
+		"L1FeeVault":        common.HexToAddress("0x03"),
+	}}
+	require.Equal(t, []FeeFlowEdge{
+		{Vault: "SequencerFeeVault", From: SequencerFeeVaultAddr, Recipient: common.HexToAddress("0x01")},
+		{Vault: "BaseFeeVault", From: BaseFeeVaultAddr, Recipient: common.HexToAddress("0x02")},
+		{Vault: "L1FeeVault", From: L1FeeVaultAddr, Recipient: common.HexToAddress("0x03")},
+	}, FeeFlow(config))
+
+	for _, edge := range FeeFlow(&testDeployConfig{}) {
+		require.Equal(t, common.Address{}, edge.Recipient)
+	}
+}
+
+func TestSequencerFeeVaultWithdrawalTarget(t *testing.T) {
+	recipient := common.HexToAddress("0x01")
+	config := &feeVaultDeployConfig{
+		recipients: map[string]common.Address{"SequencerFeeVault": recipient},
+		networks:   map[string]WithdrawalNetwork{"SequencerFeeVault": WithdrawalNetworkL1},
+	}
+	addr, network := SequencerFeeVaultWithdrawalTarget(config)
+	require.Equal(t, recipient, addr)
+	require.Equal(t, WithdrawalNetworkL1, network)
+
+	config.networks["SequencerFeeVault"] = WithdrawalNetworkL2
+	addr, network = SequencerFeeVaultWithdrawalTarget(config)
+	require.Equal(t, recipient, addr)
+	require.Equal(t, WithdrawalNetworkL2, network)
+}
+
+type minimumFeeVaultDeployConfig struct {
+	feeVaultDeployConfig
+	minimums map[string]*big.Int
+}
+
+func (c *minimumFeeVaultDeployConfig) FeeVaultMinimum(vault string) *big.Int {
+	return c.minimums[vault]
+}
+
+func TestL1FeeVaultImmutables(t *testing.T) {
+	_, ok := L1FeeVaultImmutables(&testDeployConfig{})
+	require.False(t, ok)
+
+	config := &minimumFeeVaultDeployConfig{
+		feeVaultDeployConfig: feeVaultDeployConfig{
+			recipients: map[string]common.Address{"L1FeeVault": common.HexToAddress("0x1234")},
+			networks:   map[string]WithdrawalNetwork{"L1FeeVault": WithdrawalNetworkL2},
+		},
+		minimums: map[string]*big.Int{"L1FeeVault": big.NewInt(1e18)},
+	}
+	immutables, ok := L1FeeVaultImmutables(config)
+	require.True(t, ok)
+	require.Equal(t, FeeVaultImmutables{
+		Recipient:           common.HexToAddress("0x1234"),
+		MinWithdrawalAmount: big.NewInt(1e18),
+		WithdrawalNetwork:   WithdrawalNetworkL2,
+	}, immutables)
+
+	// Without FeeVaultMinimumConfig the minimum is left unset.
+	immutables, ok = L1FeeVaultImmutables(&config.feeVaultDeployConfig)
+	require.True(t, ok)
+	require.Nil(t, immutables.MinWithdrawalAmount)
+}
+
+type sequencerFeeVaultMinimumDeployConfig struct {
+	testDeployConfig
+	minimum *big.Int
+}
+
+func (c *sequencerFeeVaultMinimumDeployConfig) SequencerFeeVaultMinimum() *big.Int {
+	return c.minimum
+}
+
+func TestSequencerFeeVaultMinWithdrawal(t *testing.T) {
+	low := &sequencerFeeVaultMinimumDeployConfig{minimum: big.NewInt(1e18)}
+	high := &sequencerFeeVaultMinimumDeployConfig{minimum: big.NewInt(2e18)}
+	require.Equal(t, big.NewInt(1e18), SequencerFeeVaultMinWithdrawal(low))
+	require.Equal(t, big.NewInt(2e18), SequencerFeeVaultMinWithdrawal(high))
+	require.NotEqual(t, SequencerFeeVaultMinWithdrawal(low), SequencerFeeVaultMinWithdrawal(high))
+	require.Nil(t, SequencerFeeVaultMinWithdrawal(&testDeployConfig{}))
+}
+
+func TestBaseFeeVaultImmutables(t *testing.T) {
+	_, ok := BaseFeeVaultImmutables(&testDeployConfig{})
+	require.False(t, ok)
+
+	config := &minimumFeeVaultDeployConfig{
+		feeVaultDeployConfig: feeVaultDeployConfig{
+			recipients: map[string]common.Address{
+				"BaseFeeVault": common.HexToAddress("0x1234"),
+				"L1FeeVault":   common.HexToAddress("0x5678"),
+			},
+			networks: map[string]WithdrawalNetwork{"BaseFeeVault": WithdrawalNetworkL2},
+		},
+		minimums: map[string]*big.Int{"BaseFeeVault": big.NewInt(1e18)},
+	}
+	immutables, ok := BaseFeeVaultImmutables(config)
+	require.True(t, ok)
+	require.Equal(t, FeeVaultImmutables{
+		Recipient:           common.HexToAddress("0x1234"),
+		MinWithdrawalAmount: big.NewInt(1e18),
+		WithdrawalNetwork:   WithdrawalNetworkL2,
+	}, immutables)
+}
+
+// ecotoneDeployConfig schedules Ecotone on top of the wrapped config.
+type ecotoneDeployConfig struct {
+	DeployConfig
+	ecotoneTime *uint64
+}
+
+func (c *ecotoneDeployConfig) ForkSchedule() ForkSchedule {
+	schedule := c.DeployConfig.ForkSchedule()
+	schedule.EcotoneTime = c.ecotoneTime
+	return schedule
+}
+
+func (c *ecotoneDeployConfig) Unwrap() DeployConfig {
+	return c.DeployConfig
+}
+
+func TestL1FeeVaultInitStorage(t *testing.T) {
+	require.Nil(t, L1FeeVaultInitStorage(&testDeployConfig{}))
+
+	feeVault := &feeVaultDeployConfig{
+		recipients: map[string]common.Address{"L1FeeVault": common.HexToAddress("0x1234")},
+		networks:   map[string]WithdrawalNetwork{"L1FeeVault": WithdrawalNetworkL2},
+	}
+	initStorage := Predeploys["L1FeeVault"].InitStorage
+
+	t.Run("pre-Ecotone", func(t *testing.T) {
+		storage := initStorage(feeVault)
+		require.Len(t, storage, 2)
+		require.Equal(t, common.HexToAddress("0x1234"), common.BytesToAddress(storage[L1FeeVaultRecipientSlot].Bytes()))
+		require.Equal(t, uint64(WithdrawalNetworkL2), storage[L1FeeVaultWithdrawalNetworkSlot].Big().Uint64())
+	})
+
+	t.Run("post-Ecotone", func(t *testing.T) {
+		config := &ecotoneDeployConfig{DeployConfig: feeVault, ecotoneTime: u64(0)}
+		storage := initStorage(config)
+		require.Equal(t, map[common.Hash]common.Hash{
+			L1FeeVaultRecipientSlot: common.HexToHash("0x010000000000000000000000000000000000001234"),
+		}, storage)
+
+		// Ecotone scheduled after genesis keeps the pre-Ecotone encoding.
+		config.ecotoneTime = u64(10)
+		require.Equal(t, initStorage(feeVault), initStorage(config))
+	})
+}
+
+func TestBaseFeeVaultInitStorage(t *testing.T) {
+	require.Nil(t, BaseFeeVaultInitStorage(&testDeployConfig{}))
+
+	feeVault := &minimumFeeVaultDeployConfig{
+		feeVaultDeployConfig: feeVaultDeployConfig{
+			recipients: map[string]common.Address{
+				"BaseFeeVault": common.HexToAddress("0x1234"),
+				"L1FeeVault":   common.HexToAddress("0x5678"),
+			},
+			networks: map[string]WithdrawalNetwork{"BaseFeeVault": WithdrawalNetworkL2},
+		},
+		minimums: map[string]*big.Int{"BaseFeeVault": big.NewInt(1e18)},
+	}
+	ecotone := &ecotoneDeployConfig{DeployConfig: feeVault, ecotoneTime: u64(0)}
+	initStorage := Predeploys["BaseFeeVault"].InitStorage
+
+	preEcotone := initStorage(feeVault)
+	require.Equal(t, map[common.Hash]common.Hash{
+		L1FeeVaultRecipientSlot:         common.HexToHash("0x1234"),
+		L1FeeVaultWithdrawalNetworkSlot: common.HexToHash("0x01"),
+		BaseFeeVaultMinWithdrawalSlot:   common.BigToHash(big.NewInt(1e18)),
+	}, preEcotone)
+
+	postEcotone := initStorage(ecotone)
+	require.Equal(t, map[common.Hash]common.Hash{
+		L1FeeVaultRecipientSlot:       common.HexToHash("0x010000000000000000000000000000000000001234"),
+		BaseFeeVaultMinWithdrawalSlot: common.BigToHash(big.NewInt(1e18)),
+	}, postEcotone)
+	require.NotEqual(t, preEcotone[L1FeeVaultRecipientSlot], postEcotone[L1FeeVaultRecipientSlot])
+
+	// Without FeeVaultMinimumConfig the minimum is not seeded.
+	require.NotContains(t, initStorage(&feeVault.feeVaultDeployConfig), BaseFeeVaultMinWithdrawalSlot)
+}
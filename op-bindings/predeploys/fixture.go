@@ -0,0 +1,192 @@
+package predeploys
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+var (
+	// implementationSlot is the EIP-1967 implementation storage slot.
+	implementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// adminSlot is the EIP-1967 admin storage slot.
+	adminSlot = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+
+	// stubCode is the placeholder bytecode used by TestFixture.
+	stubCode = []byte{0x00}
+)
+
+// TestFixture returns a minimal genesis alloc containing every registered
+// predeploy with stub code. Proxied predeploys get a proxy account whose
+// EIP-1967 slots point at a stub implementation and at the ProxyAdmin.
+// It is meant to be used as a fixture by tests in other packages.
+func TestFixture() map[common.Address]core.GenesisAccount {
+	alloc := make(map[common.Address]core.GenesisAccount)
+	for _, predeploy := range registered() {
+		if predeploy.ProxyDisabled {
+			alloc[predeploy.Address] = core.GenesisAccount{Code: stubCode, Balance: common.Big0}
+			continue
+		}
+		impl, err := ImplementationAddress(predeploy.Address)
+		if err != nil {
+			panic(err)
+		}
+		alloc[impl] = core.GenesisAccount{Code: stubCode, Balance: common.Big0}
+		alloc[predeploy.Address] = core.GenesisAccount{
+			Code: stubCode,
+			Storage: map[common.Hash]common.Hash{
+				implementationSlot: common.BytesToHash(impl.Bytes()),
+				adminSlot:          common.BytesToHash(ProxyAdminAddr.Bytes()),
+			},
+			Balance: common.Big0,
+		}
+	}
+	return alloc
+}
+
+// AssertGenesisComplete checks that every predeploy active for the config
+// has code in the alloc and, if proxied, points at an implementation with code.
+func AssertGenesisComplete(alloc map[common.Address]core.GenesisAccount, config DeployConfig) error {
+	active := ActivePredeploys(config)
+	names := make([]string, 0, len(active))
+	for name := range active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		predeploy := active[name]
+		account, ok := alloc[predeploy.Address]
+		if !ok || len(account.Code) == 0 {
+			return fmt.Errorf("no code for predeploy %s at %s", name, predeploy.Address)
+		}
+		if predeploy.ProxyDisabled {
+			continue
+		}
+		impl := common.BytesToAddress(account.Storage[implementationSlot].Bytes())
+		if implAccount, ok := alloc[impl]; !ok || len(implAccount.Code) == 0 {
+			return fmt.Errorf("no implementation code for predeploy %s at %s", name, impl)
+		}
+	}
+	return nil
+}
+
+// expectedStorage returns the storage slots the genesis account of an active
+// predeploy is expected to hold: its proxy slots, if proxied, and InitStorage.
+func expectedStorage(predeploy *Predeploy, config DeployConfig) (map[common.Hash]common.Hash, error) {
+	storage := make(map[common.Hash]common.Hash)
+	if !predeploy.ProxyDisabled {
+		impl, err := ImplementationAddress(predeploy.Address)
+		if err != nil {
+			return nil, err
+		}
+		storage[implementationSlot] = common.BytesToHash(impl.Bytes())
+		storage[adminSlot] = common.BytesToHash(ProxyAdminAddr.Bytes())
+	}
+	if predeploy.InitStorage != nil {
+		for key, value := range predeploy.InitStorage(config) {
+			storage[key] = value
+		}
+	}
+	return storage, nil
+}
+
+// AssertGenesisHash checks that the predeploy accounts of a fully built
+// genesis have not been mangled: every active predeploy must have code and
+// hold the proxy and init storage slots expected for the config. The first
+// mismatch is reported with the name of the predeploy.
+func AssertGenesisHash(genesis *core.Genesis, config DeployConfig) error {
+	active := ActivePredeploys(config)
+	names := make([]string, 0, len(active))
+	for name := range active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		predeploy := active[name]
+		account, ok := genesis.Alloc[predeploy.Address]
+		if !ok || len(account.Code) == 0 {
+			return fmt.Errorf("predeploy %s: no code at %s", name, predeploy.Address)
+		}
+		expected, err := expectedStorage(predeploy, config)
+		if err != nil {
+			return fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		for key, value := range expected {
+			if got := account.Storage[key]; got != value {
+				return fmt.Errorf("predeploy %s: slot %s is %s, expected %s", name, key, got, value)
+			}
+		}
+	}
+	return nil
+}
+
+// VerifyProxyAdminWiring returns the sorted names of the proxied predeploys
+// in the alloc whose EIP-1967 admin slot does not point at the ProxyAdmin.
+// Predeploys missing from the alloc are skipped.
+func VerifyProxyAdminWiring(alloc map[common.Address]core.GenesisAccount) []string {
+	want := common.BytesToHash(ProxyAdminAddr.Bytes())
+	var names []string
+	for name, predeploy := range registered() {
+		if predeploy.ProxyDisabled {
+			continue
+		}
+		account, ok := alloc[predeploy.Address]
+		if !ok {
+			continue
+		}
+		if account.Storage[adminSlot] != want {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenesisAccountSizes returns the encoded size in bytes, code plus storage,
+// of the genesis account of each active predeploy. The code is looked up by
+// predeploy name; each expected storage slot counts as a 32 byte key and a
+// 32 byte value.
+func GenesisAccountSizes(config DeployConfig, code map[string][]byte) map[string]int {
+	sizes := make(map[string]int)
+	for name, predeploy := range ActivePredeploys(config) {
+		size := len(code[name])
+		if storage, err := expectedStorage(predeploy, config); err == nil {
+			size += len(storage) * 2 * common.HashLength
+		}
+		sizes[name] = size
+	}
+	return sizes
+}
+
+// ValidateAgainstReference compares the predeploy accounts expected for the
+// config with those of a reference genesis alloc, and returns the sorted
+// names of the active predeploys whose reference account is missing, has no
+// code, or does not hold the expected proxy and init storage slots. Code is
+// not compared byte for byte since it depends on immutables set at build time.
+func ValidateAgainstReference(ref map[common.Address]core.GenesisAccount, config DeployConfig) []string {
+	var names []string
+	for name, predeploy := range ActivePredeploys(config) {
+		account, ok := ref[predeploy.Address]
+		if !ok || len(account.Code) == 0 {
+			names = append(names, name)
+			continue
+		}
+		expected, err := expectedStorage(predeploy, config)
+		if err != nil {
+			names = append(names, name)
+			continue
+		}
+		for key, value := range expected {
+			if account.Storage[key] != value {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
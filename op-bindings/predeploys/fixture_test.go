@@ -0,0 +1,100 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestFixture(t *testing.T) {
+	alloc := TestFixture()
+	require.NoError(t, AssertGenesisComplete(alloc, &testDeployConfig{}))
+	require.NoError(t, AssertGenesisComplete(alloc, &testDeployConfig{governance: true, canyonTime: u64(0)}))
+
+	// Proxy-disabled predeploys hold their code directly.
+	require.NotEmpty(t, alloc[WETH9Addr].Code)
+	require.Empty(t, alloc[WETH9Addr].Storage)
+
+	// Proxied predeploys point at their implementation.
+	impl, err := ImplementationAddress(L2StandardBridgeAddr)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(impl.Bytes()), alloc[L2StandardBridgeAddr].Storage[implementationSlot])
+	require.NotEmpty(t, alloc[impl].Code)
+
+	delete(alloc, impl)
+	require.ErrorContains(t, AssertGenesisComplete(alloc, &testDeployConfig{}), "L2StandardBridge")
+	alloc = TestFixture()
+	delete(alloc, WETH9Addr)
+	require.ErrorContains(t, AssertGenesisComplete(alloc, &testDeployConfig{}), "WETH9")
+}
+
+func TestAssertGenesisHash(t *testing.T) {
+	config := &testDeployConfig{}
+	genesis, err := DevGenesis(config)
+	require.NoError(t, err)
+	require.NoError(t, AssertGenesisHash(genesis, config))
+
+	account := genesis.Alloc[L2StandardBridgeAddr]
+	account.Storage = map[common.Hash]common.Hash{
+		implementationSlot: common.BytesToHash(common.HexToAddress("0x1234").Bytes()),
+		adminSlot:          common.BytesToHash(ProxyAdminAddr.Bytes()),
+	}
+	genesis.Alloc[L2StandardBridgeAddr] = account
+	require.ErrorContains(t, AssertGenesisHash(genesis, config), "predeploy L2StandardBridge: slot")
+
+	delete(genesis.Alloc, L1BlockAddr)
+	require.ErrorContains(t, AssertGenesisHash(genesis, config), "predeploy L1Block: no code")
+}
+
+func TestVerifyProxyAdminWiring(t *testing.T) {
+	alloc := TestFixture()
+	require.Empty(t, VerifyProxyAdminWiring(alloc))
+
+	alloc[L1BlockAddr].Storage[adminSlot] = common.HexToHash("0x1234")
+	require.Equal(t, []string{"L1Block"}, VerifyProxyAdminWiring(alloc))
+}
+
+func TestGenesisAccountSizes(t *testing.T) {
+	config := &testDeployConfig{canyonTime: u64(0)}
+	code := map[string][]byte{
+		"L2StandardBridge": make([]byte, 100),
+		"Create2Deployer":  make([]byte, 10),
+	}
+	sizes := GenesisAccountSizes(config, code)
+	require.Len(t, sizes, len(ActivePredeploys(config)))
+
+	// Proxied predeploys carry the two EIP-1967 slots.
+	require.Equal(t, 100+2*64, sizes["L2StandardBridge"])
+	require.Equal(t, 2*64, sizes["L1Block"])
+	require.Equal(t, 10, sizes["Create2Deployer"])
+
+	total, want := 0, 110
+	for name, predeploy := range ActivePredeploys(config) {
+		total += sizes[name]
+		if !predeploy.ProxyDisabled {
+			want += 2 * 64
+		}
+		if predeploy.InitStorage != nil {
+			want += len(predeploy.InitStorage(config)) * 64
+		}
+	}
+	require.Equal(t, want, total)
+}
+
+func TestValidateAgainstReference(t *testing.T) {
+	config := &testDeployConfig{}
+	genesis, err := DevGenesis(config)
+	require.NoError(t, err)
+	require.Empty(t, ValidateAgainstReference(genesis.Alloc, config))
+
+	bridge := genesis.Alloc[L2StandardBridgeAddr]
+	bridge.Storage = map[common.Hash]common.Hash{
+		implementationSlot: common.HexToHash("0x1234"),
+		adminSlot:          common.BytesToHash(ProxyAdminAddr.Bytes()),
+	}
+	genesis.Alloc[L2StandardBridgeAddr] = bridge
+	delete(genesis.Alloc, L1BlockAddr)
+	require.Equal(t, []string{"L1Block", "L2StandardBridge"}, ValidateAgainstReference(genesis.Alloc, config))
+}
@@ -0,0 +1,150 @@
+package predeploys
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForkSchedule holds the activation offsets, relative to L2 genesis, of the
+// forks that predeploy enablement depends on. A nil offset means the fork is
+// not scheduled.
+type ForkSchedule struct {
+	CanyonTime  *uint64
+	EcotoneTime *uint64
+	FjordTime   *uint64
+	// GenesisTime is the L2 genesis timestamp the offsets are relative to.
+	GenesisTime uint64
+	// Published reports whether the schedule is published to L2 contracts
+	// through the L2ForkSchedule predeploy.
+	Published bool
+}
+
+// forkTime returns the activation offset, relative to L2 genesis, of the
+// named fork, or false if the fork is unknown or not scheduled. "genesis"
+// is always active at offset 0.
+func (s ForkSchedule) forkTime(fork string) (uint64, bool) {
+	var t *uint64
+	switch strings.ToLower(fork) {
+	case "genesis":
+		return 0, true
+	case "canyon":
+		t = s.CanyonTime
+	case "ecotone":
+		t = s.EcotoneTime
+	case "fjord":
+		t = s.FjordTime
+	}
+	if t == nil {
+		return 0, false
+	}
+	return *t, true
+}
+
+// enabledAtOrAfter returns an Enabled function that is true once the fork
+// returned by get is active: its activation offset is set and not later than
+// the time enablement is evaluated at, which is genesis by default.
+func enabledAtOrAfter(get func(DeployConfig) *uint64) func(DeployConfig) bool {
+	return func(config DeployConfig) bool {
+		forkTime := get(config)
+		return forkTime != nil && *forkTime <= evaluationTime(config)
+	}
+}
+
+// ecotoneTime returns the Ecotone activation offset of the config.
+func ecotoneTime(config DeployConfig) *uint64 {
+	return config.ForkSchedule().EcotoneTime
+}
+
+var (
+	// CanyonTimeSlot is the L2ForkSchedule slot holding the Canyon activation timestamp.
+	CanyonTimeSlot = common.BigToHash(common.Big0)
+	// EcotoneTimeSlot is the L2ForkSchedule slot holding the Ecotone activation timestamp.
+	EcotoneTimeSlot = common.BigToHash(common.Big1)
+	// FjordTimeSlot is the L2ForkSchedule slot holding the Fjord activation timestamp.
+	FjordTimeSlot = common.BigToHash(common.Big2)
+
+	// forkUnscheduled is stored for forks that are not scheduled.
+	forkUnscheduled = common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+)
+
+// forkTimeValue encodes the activation timestamp of a fork scheduled at
+// the offset t from the L2 genesis timestamp.
+func forkTimeValue(genesisTime uint64, t *uint64) common.Hash {
+	if t == nil || *t == math.MaxUint64 {
+		return forkUnscheduled
+	}
+	v := new(big.Int).SetUint64(genesisTime)
+	return common.BigToHash(v.Add(v, new(big.Int).SetUint64(*t)))
+}
+
+// forkScheduleStorage seeds the L2ForkSchedule with the activation
+// timestamps of the forks, that is the L2 genesis timestamp plus their
+// offsets. Unscheduled forks are stored as type(uint256).max.
+func forkScheduleStorage(config DeployConfig) map[common.Hash]common.Hash {
+	schedule := config.ForkSchedule()
+	return map[common.Hash]common.Hash{
+		CanyonTimeSlot:  forkTimeValue(schedule.GenesisTime, schedule.CanyonTime),
+		EcotoneTimeSlot: forkTimeValue(schedule.GenesisTime, schedule.EcotoneTime),
+		FjordTimeSlot:   forkTimeValue(schedule.GenesisTime, schedule.FjordTime),
+	}
+}
+
+// codeChangingForks are the forks that change the bytecode of the
+// ForkDependentPredeploys.
+var codeChangingForks = []string{"ecotone", "fjord"}
+
+// PredeployChangesBetweenForks describes how the active predeploys change
+// from the activation of fromFork to that of toFork, e.g. "canyon" and
+// "ecotone". Forks are named case-insensitively; "genesis" refers to L2
+// genesis. The added and removed predeploys are found by comparing the
+// enablement at both activation times. codeChanged lists the
+// ForkDependentPredeploys active on both sides when a fork changing their
+// bytecode activates in between. All results are sorted by name, and are
+// empty if either fork is unknown or not scheduled.
+func PredeployChangesBetweenForks(config DeployConfig, fromFork, toFork string) (added, removed, codeChanged []string) {
+	schedule := config.ForkSchedule()
+	from, ok := schedule.forkTime(fromFork)
+	if !ok {
+		return nil, nil, nil
+	}
+	to, ok := schedule.forkTime(toFork)
+	if !ok {
+		return nil, nil, nil
+	}
+	before := ActiveAtTimestamp(config, from)
+	after := ActiveAtTimestamp(config, to)
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	crossed := false
+	for _, fork := range codeChangingForks {
+		if t, ok := schedule.forkTime(fork); ok && from < t && t <= to {
+			crossed = true
+		}
+	}
+	if crossed {
+		for _, name := range ForkDependentPredeploys() {
+			_, inBefore := before[name]
+			_, inAfter := after[name]
+			if inBefore && inAfter {
+				codeChanged = append(codeChanged, name)
+			}
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(codeChanged)
+	return added, removed, codeChanged
+}
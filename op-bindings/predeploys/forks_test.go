@@ -0,0 +1,109 @@
+package predeploys
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type forkDeployConfig struct {
+	testDeployConfig
+	scheduleEnabled bool
+	genesisTime     uint64
+	ecotoneTime     *uint64
+	fjordTime       *uint64
+}
+
+func (c *forkDeployConfig) ForkSchedule() ForkSchedule {
+	return ForkSchedule{
+		CanyonTime:  c.canyonTime,
+		EcotoneTime: c.ecotoneTime,
+		FjordTime:   c.fjordTime,
+		GenesisTime: c.genesisTime,
+		Published:   c.scheduleEnabled,
+	}
+}
+
+func TestL2ForkSchedule(t *testing.T) {
+	predeploy := Predeploys["L2ForkSchedule"]
+	require.Equal(t, predeploy, PredeploysByAddress[L2ForkScheduleAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "L2ForkSchedule")
+
+	config := &forkDeployConfig{
+		testDeployConfig: testDeployConfig{canyonTime: u64(0)},
+		genesisTime:      1700000000,
+		ecotoneTime:      u64(1000),
+	}
+	require.NotContains(t, ActivePredeploys(config), "L2ForkSchedule")
+	config.scheduleEnabled = true
+	require.Contains(t, ActivePredeploys(config), "L2ForkSchedule")
+	require.Equal(t, map[common.Hash]common.Hash{
+		CanyonTimeSlot:  common.BigToHash(big.NewInt(1700000000)),
+		EcotoneTimeSlot: common.BigToHash(big.NewInt(1700001000)),
+		FjordTimeSlot:   forkUnscheduled,
+	}, predeploy.InitStorage(config))
+
+	config.fjordTime = u64(math.MaxUint64)
+	require.Equal(t, forkUnscheduled, predeploy.InitStorage(config)[FjordTimeSlot])
+}
+
+// timestampedConfig evaluates enablement of the wrapped config at a later
+// L2 timestamp.
+type timestampedConfig struct {
+	DeployConfig
+	timestamp uint64
+}
+
+func (c *timestampedConfig) Timestamp() uint64 {
+	return c.timestamp
+}
+
+func TestEnabledAtOrAfter(t *testing.T) {
+	enabled := enabledAtOrAfter(func(config DeployConfig) *uint64 {
+		return config.ForkSchedule().CanyonTime
+	})
+	t.Run("fork at genesis", func(t *testing.T) {
+		require.True(t, enabled(&testDeployConfig{canyonTime: u64(0)}))
+	})
+	t.Run("fork scheduled later", func(t *testing.T) {
+		config := &testDeployConfig{canyonTime: u64(10)}
+		require.False(t, enabled(config))
+		require.False(t, enabled(&timestampedConfig{DeployConfig: config, timestamp: 9}))
+		require.True(t, enabled(&timestampedConfig{DeployConfig: config, timestamp: 10}))
+	})
+	t.Run("fork unset", func(t *testing.T) {
+		require.False(t, enabled(&testDeployConfig{}))
+		require.False(t, enabled(&timestampedConfig{DeployConfig: &testDeployConfig{}, timestamp: 1000}))
+	})
+}
+
+func TestForkScheduleCreate2Deployer(t *testing.T) {
+	require.Contains(t, ActivePredeploys(&testDeployConfig{canyonTime: u64(0)}), "Create2Deployer")
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{canyonTime: u64(100)}), "Create2Deployer")
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "Create2Deployer")
+}
+
+func TestPredeployChangesBetweenForks(t *testing.T) {
+	config := &forkDeployConfig{
+		testDeployConfig: testDeployConfig{canyonTime: u64(100)},
+		ecotoneTime:      u64(200),
+	}
+	added, removed, codeChanged := PredeployChangesBetweenForks(config, "genesis", "canyon")
+	require.Equal(t, []string{"Create2Deployer"}, added)
+	require.Empty(t, removed)
+	require.Empty(t, codeChanged)
+
+	added, removed, codeChanged = PredeployChangesBetweenForks(config, "Canyon", "Ecotone")
+	require.Empty(t, added)
+	require.Empty(t, removed)
+	require.Equal(t, []string{"GasPriceOracle", "L1Block"}, codeChanged)
+
+	added, removed, codeChanged = PredeployChangesBetweenForks(config, "canyon", "fjord")
+	require.Nil(t, added)
+	require.Nil(t, removed)
+	require.Nil(t, codeChanged)
+}
@@ -0,0 +1,223 @@
+package predeploys
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultInitGas is the gas limit of init calls of predeploys that do not
+// configure InitGas.
+const DefaultInitGas uint64 = 1_000_000
+
+// PredeployInitCall is a call that initializes a predeploy.
+type PredeployInitCall struct {
+	Name string
+	To   common.Address
+	Data []byte
+	Gas  uint64
+}
+
+// packInit returns an InitCalldata function that encodes a call to the
+// argument-less initialize() function of the binding.
+func packInit(metadata *bind.MetaData) func(config DeployConfig) ([]byte, error) {
+	return func(config DeployConfig) ([]byte, error) {
+		parsed, err := metadata.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		return parsed.Pack("initialize")
+	}
+}
+
+// initGas returns the gas limit of the init call of the predeploy.
+func initGas(predeploy *Predeploy) uint64 {
+	if predeploy.InitGas == 0 {
+		return DefaultInitGas
+	}
+	return predeploy.InitGas
+}
+
+// initCall builds the init call of the predeploy.
+func initCall(name string, predeploy *Predeploy, config DeployConfig) (PredeployInitCall, error) {
+	data, err := predeploy.InitCalldata(config)
+	if err != nil {
+		return PredeployInitCall{}, fmt.Errorf("failed to build init calldata for %s: %w", name, err)
+	}
+	return PredeployInitCall{
+		Name: name,
+		To:   predeploy.Address,
+		Data: data,
+		Gas:  initGas(predeploy),
+	}, nil
+}
+
+// InitCalls returns the init calls of the active predeploys, sorted by name.
+func InitCalls(config DeployConfig) ([]PredeployInitCall, error) {
+	var calls []PredeployInitCall
+	for name, predeploy := range ActivePredeploys(config) {
+		if predeploy.InitCalldata == nil {
+			continue
+		}
+		call, err := initCall(name, predeploy, config)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i].Name < calls[j].Name
+	})
+	return calls, nil
+}
+
+// ValidateInitCalldata checks that the init calldata of every active
+// predeploy calls a function of its ABI, i.e. that its leading 4-byte
+// selector is known. Predeploys without an ABI cannot be checked and are
+// reported as errors.
+func ValidateInitCalldata(config DeployConfig) error {
+	calls, err := InitCalls(config)
+	if err != nil {
+		return err
+	}
+	active := ActivePredeploys(config)
+	for _, call := range calls {
+		predeploy := active[call.Name]
+		if predeploy.ABI == nil {
+			return fmt.Errorf("predeploy %s has init calldata but no ABI", call.Name)
+		}
+		if _, err := predeploy.ABI().MethodById(call.Data); err != nil {
+			return fmt.Errorf("invalid init calldata for %s: %w", call.Name, err)
+		}
+	}
+	return nil
+}
+
+// TopoSortForInit returns the init calls of the active predeploys ordered so
+// that every predeploy is initialized after its InitDependsOn. Among init
+// calls that are ready at the same time, lower InitPriority goes first,
+// then the name breaks ties. Dependencies without an init call are ignored.
+func TopoSortForInit(config DeployConfig) ([]PredeployInitCall, error) {
+	calls, err := InitCalls(config)
+	if err != nil {
+		return nil, err
+	}
+	predeploys := registered()
+	byName := make(map[string]PredeployInitCall, len(calls))
+	for _, call := range calls {
+		byName[call.Name] = call
+	}
+
+	indegree := make(map[string]int, len(calls))
+	dependents := make(map[string][]string)
+	for _, call := range calls {
+		for _, dep := range predeploys[call.Name].InitDependsOn {
+			if _, ok := byName[dep]; !ok {
+				continue
+			}
+			indegree[call.Name]++
+			dependents[dep] = append(dependents[dep], call.Name)
+		}
+	}
+
+	var ready []string
+	for _, call := range calls {
+		if indegree[call.Name] == 0 {
+			ready = append(ready, call.Name)
+		}
+	}
+	sorted := make([]PredeployInitCall, 0, len(calls))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			pi, pj := predeploys[ready[i]].InitPriority, predeploys[ready[j]].InitPriority
+			if pi != pj {
+				return pi < pj
+			}
+			return ready[i] < ready[j]
+		})
+		name := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, byName[name])
+		for _, dependent := range dependents[name] {
+			indegree[dependent]--
+			if indegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(sorted) != len(calls) {
+		return nil, fmt.Errorf("cyclic init dependencies between predeploys")
+	}
+	return sorted, nil
+}
+
+// EstimateGenesisGas returns the gas needed by the init calls of the active
+// predeploys, the sum of their gas limits. The gas limit of the genesis
+// block must accommodate it.
+func EstimateGenesisGas(config DeployConfig) uint64 {
+	var gas uint64
+	for _, predeploy := range ActivePredeploys(config) {
+		if predeploy.InitCalldata != nil {
+			gas += initGas(predeploy)
+		}
+	}
+	return gas
+}
+
+// PendingInit returns the init calls of the active predeploys that are not
+// marked as done, in the order of TopoSortForInit. It lets an interrupted
+// deployment resume where it stopped.
+func PendingInit(config DeployConfig, done map[string]bool) ([]PredeployInitCall, error) {
+	calls, err := TopoSortForInit(config)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]PredeployInitCall, 0, len(calls))
+	for _, call := range calls {
+		if !done[call.Name] {
+			pending = append(pending, call)
+		}
+	}
+	return pending, nil
+}
+
+// ExpectedReceipt describes the receipt expected for the init call of a
+// predeploy. Events holds the topic0 of the events the init call is expected
+// to emit from the predeploy address, in order.
+type ExpectedReceipt struct {
+	Name   string
+	To     common.Address
+	Status uint64
+	Events []common.Hash
+}
+
+// ExpectedInitReceipts returns the receipts expected for the init calls of
+// the active predeploys, sorted by name. Init calls succeed and, if the ABI
+// of the predeploy declares the Initialized(uint8) event of OpenZeppelin's
+// Initializable, emit it.
+func ExpectedInitReceipts(config DeployConfig) []ExpectedReceipt {
+	var receipts []ExpectedReceipt
+	for name, predeploy := range ActivePredeploys(config) {
+		if predeploy.InitCalldata == nil {
+			continue
+		}
+		receipt := ExpectedReceipt{
+			Name:   name,
+			To:     predeploy.Address,
+			Status: types.ReceiptStatusSuccessful,
+		}
+		if predeploy.ABI != nil {
+			if event, ok := predeploy.ABI().Events["Initialized"]; ok {
+				receipt.Events = append(receipt.Events, event.ID)
+			}
+		}
+		receipts = append(receipts, receipt)
+	}
+	sort.Slice(receipts, func(i, j int) bool {
+		return receipts[i].Name < receipts[j].Name
+	})
+	return receipts
+}
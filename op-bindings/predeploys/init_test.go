@@ -0,0 +1,150 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitCalls(t *testing.T) {
+	calls, err := InitCalls(&testDeployConfig{})
+	require.NoError(t, err)
+
+	var messenger *PredeployInitCall
+	for i := range calls {
+		if calls[i].Name == "L2CrossDomainMessenger" {
+			messenger = &calls[i]
+		}
+	}
+	require.NotNil(t, messenger)
+	require.Equal(t, L2CrossDomainMessengerAddr, messenger.To)
+	// initialize()
+	require.Equal(t, hexutil.MustDecode("0x8129fc1c"), messenger.Data)
+	require.Equal(t, uint64(200_000), messenger.Gas)
+}
+
+func TestInitCallsDefaultGas(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	require.NoError(t, Register("TestInit", &Predeploy{
+		Address: addr,
+		InitCalldata: func(config DeployConfig) ([]byte, error) {
+			return []byte{0x01}, nil
+		},
+	}))
+
+	calls, err := InitCalls(&testDeployConfig{})
+	require.NoError(t, err)
+	require.Contains(t, calls, PredeployInitCall{Name: "TestInit", To: addr, Data: []byte{0x01}, Gas: DefaultInitGas})
+}
+
+func registerInit(t *testing.T, name string, addr common.Address, priority int, deps ...string) {
+	require.NoError(t, Register(name, &Predeploy{
+		Address: addr,
+		InitCalldata: func(config DeployConfig) ([]byte, error) {
+			return []byte{0x01}, nil
+		},
+		InitDependsOn: deps,
+		InitPriority:  priority,
+	}))
+}
+
+func initOrder(t *testing.T) []string {
+	calls, err := TopoSortForInit(&testDeployConfig{})
+	require.NoError(t, err)
+	var names []string
+	for _, call := range calls {
+		if call.Name != "L2CrossDomainMessenger" {
+			names = append(names, call.Name)
+		}
+	}
+	return names
+}
+
+func TestTopoSortForInit(t *testing.T) {
+	restoreRegistry(t)
+	registerInit(t, "TestA", common.HexToAddress("0x42000000000000000000000000000000000000f0"), 0)
+	registerInit(t, "TestB", common.HexToAddress("0x42000000000000000000000000000000000000f1"), 0, "TestC")
+	registerInit(t, "TestC", common.HexToAddress("0x42000000000000000000000000000000000000f2"), 0)
+	registerInit(t, "TestD", common.HexToAddress("0x42000000000000000000000000000000000000f3"), -1)
+	require.Equal(t, []string{"TestD", "TestA", "TestC", "TestB"}, initOrder(t))
+}
+
+func TestTopoSortForInitCycle(t *testing.T) {
+	restoreRegistry(t)
+	registerInit(t, "TestA", common.HexToAddress("0x42000000000000000000000000000000000000f0"), 0, "TestB")
+	registerInit(t, "TestB", common.HexToAddress("0x42000000000000000000000000000000000000f1"), 0, "TestA")
+	_, err := TopoSortForInit(&testDeployConfig{})
+	require.ErrorContains(t, err, "cyclic")
+}
+
+func TestPendingInit(t *testing.T) {
+	restoreRegistry(t)
+	registerInit(t, "TestA", common.HexToAddress("0x42000000000000000000000000000000000000f0"), 0)
+	registerInit(t, "TestB", common.HexToAddress("0x42000000000000000000000000000000000000f1"), 0, "TestC")
+	registerInit(t, "TestC", common.HexToAddress("0x42000000000000000000000000000000000000f2"), 0)
+	registerInit(t, "TestD", common.HexToAddress("0x42000000000000000000000000000000000000f3"), -1)
+
+	done := map[string]bool{"L2CrossDomainMessenger": true, "TestD": true, "TestC": true}
+	calls, err := PendingInit(&testDeployConfig{}, done)
+	require.NoError(t, err)
+	var names []string
+	for _, call := range calls {
+		names = append(names, call.Name)
+	}
+	require.Equal(t, []string{"TestA", "TestB"}, names)
+}
+
+func TestExpectedInitReceipts(t *testing.T) {
+	receipts := ExpectedInitReceipts(&testDeployConfig{})
+	var messenger *ExpectedReceipt
+	for i := range receipts {
+		if receipts[i].Name == "L2CrossDomainMessenger" {
+			messenger = &receipts[i]
+		}
+	}
+	require.NotNil(t, messenger)
+	require.Equal(t, L2CrossDomainMessengerAddr, messenger.To)
+	require.Equal(t, uint64(1), messenger.Status)
+	require.Equal(t, []common.Hash{crypto.Keccak256Hash([]byte("Initialized(uint8)"))}, messenger.Events)
+}
+
+func TestEstimateGenesisGas(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{}
+	base := EstimateGenesisGas(config)
+	require.Equal(t, uint64(200_000), base)
+
+	calldata := func(config DeployConfig) ([]byte, error) {
+		return []byte{0x01}, nil
+	}
+	require.NoError(t, Register("TestGasA", &Predeploy{
+		Address:      common.HexToAddress("0x42000000000000000000000000000000000000f0"),
+		InitCalldata: calldata,
+		InitGas:      50_000,
+	}))
+	require.NoError(t, Register("TestGasB", &Predeploy{
+		Address:      common.HexToAddress("0x42000000000000000000000000000000000000f1"),
+		InitCalldata: calldata,
+	}))
+	require.Equal(t, base+50_000+DefaultInitGas, EstimateGenesisGas(config))
+}
+
+func TestValidateInitCalldata(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{}
+	require.NoError(t, ValidateInitCalldata(config))
+
+	require.NoError(t, Register("TestUnknownSelector", &Predeploy{
+		Address: common.HexToAddress("0x42000000000000000000000000000000000000f0"),
+		ABI:     lazyABI(bindings.L2CrossDomainMessengerMetaData),
+		InitCalldata: func(config DeployConfig) ([]byte, error) {
+			return []byte{0xde, 0xad, 0xbe, 0xef}, nil
+		},
+	}))
+	require.ErrorContains(t, ValidateInitCalldata(config), "TestUnknownSelector")
+}
@@ -0,0 +1,150 @@
+package predeploys
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ecotoneLayoutVersion is the storage layout version of the L1Block and of
+// the GasPriceOracle as of Ecotone. From it on, the L1Block holds the blob
+// base fee at slot 7 and packs the Ecotone fee scalars into slot 3.
+const ecotoneLayoutVersion = "1.2.0"
+
+var (
+	// blobBaseFeeSlot is the L1Block slot holding the L1 blob base fee from
+	// its Ecotone layout onwards.
+	blobBaseFeeSlot = common.BigToHash(big.NewInt(7))
+)
+
+// BlobBaseFeeSlot returns the storage slot of the L1 blob base fee exposed by
+// the GasPriceOracle. The GasPriceOracle reads it from the L1Block, so the
+// slot is slot 7 of the L1Block storage, as laid out from the Ecotone
+// version 1.2.0 of the L1Block on. Older layouts do not have it.
+func BlobBaseFeeSlot() common.Hash {
+	return blobBaseFeeSlot
+}
+
+// ReadBlobBaseFee decodes the L1 blob base fee from a storage dump of the
+// L1Block. It is supported by the L1Block and by the GasPriceOracle, whose
+// blobBaseFee reads through to the L1Block, so for both the dump is that of
+// the L1Block. It returns false for other predeploys, if the predeploy or
+// the registered L1Block predate the Ecotone storage layout, or if the slot
+// is not present in the dump.
+func (p *Predeploy) ReadBlobBaseFee(storage map[common.Hash]common.Hash) (*big.Int, bool) {
+	if p.Address != L1BlockAddr && p.Address != GasPriceOracleAddr {
+		return nil, false
+	}
+	if !hasEcotoneLayout(p) {
+		return nil, false
+	}
+	if p.Address == GasPriceOracleAddr {
+		l1Block, ok := lookup("L1Block")
+		if !ok || !hasEcotoneLayout(l1Block) {
+			return nil, false
+		}
+	}
+	value, ok := storage[blobBaseFeeSlot]
+	if !ok {
+		return nil, false
+	}
+	return value.Big(), true
+}
+
+// hasEcotoneLayout reports whether the storage layout version of the
+// predeploy is at least ecotoneLayoutVersion.
+func hasEcotoneLayout(p *Predeploy) bool {
+	version, ok := parseLayoutVersion(p.StorageLayoutVersion)
+	if !ok {
+		return false
+	}
+	ecotone, _ := parseLayoutVersion(ecotoneLayoutVersion)
+	for i := range version {
+		if version[i] != ecotone[i] {
+			return version[i] > ecotone[i]
+		}
+	}
+	return true
+}
+
+// parseLayoutVersion parses a major.minor.patch storage layout version.
+func parseLayoutVersion(version string) ([3]uint64, bool) {
+	var v [3]uint64
+	var rest string
+	n, _ := fmt.Sscanf(version, "%d.%d.%d%s", &v[0], &v[1], &v[2], &rest)
+	return v, n == 3
+}
+
+var (
+	// l1BlockNumberTimestampSlot packs the L1 block number in its lowest 8
+	// bytes and the L1 timestamp in the 8 bytes above.
+	l1BlockNumberTimestampSlot = common.BigToHash(big.NewInt(0))
+	l1BlockBasefeeSlot         = common.BigToHash(big.NewInt(1))
+	l1BlockHashSlot            = common.BigToHash(big.NewInt(2))
+	// l1BlockSequenceNumberSlot packs the sequence number in its lowest 8
+	// bytes. From the Ecotone layout on, the blob base fee scalar and the
+	// base fee scalar follow in the 4 bytes above each.
+	l1BlockSequenceNumberSlot = common.BigToHash(big.NewInt(3))
+	l1BlockBatcherHashSlot    = common.BigToHash(big.NewInt(4))
+	l1BlockFeeOverheadSlot    = common.BigToHash(big.NewInt(5))
+	l1BlockFeeScalarSlot      = common.BigToHash(big.NewInt(6))
+)
+
+// L1BlockData holds the L1 attributes stored by the L1Block predeploy. The
+// Ecotone fields are zero for dumps of the layouts predating Ecotone.
+type L1BlockData struct {
+	Number         uint64
+	Timestamp      uint64
+	BaseFee        *big.Int
+	Hash           common.Hash
+	SequenceNumber uint64
+	BatcherHash    common.Hash
+	L1FeeOverhead  *big.Int
+	L1FeeScalar    *big.Int
+
+	// BlobBaseFeeScalar, BaseFeeScalar and BlobBaseFee are set from Ecotone
+	// on.
+	BlobBaseFeeScalar uint32
+	BaseFeeScalar     uint32
+	BlobBaseFee       *big.Int
+}
+
+// DecodeL1Block decodes the L1 attributes from a storage dump of the L1Block
+// predeploy, in its Ecotone layout or in one of the layouts before, whose
+// slots are a subset of it. Slots missing from the dump are zero, as in the
+// state trie. It returns an error if a packed slot holds bits outside its
+// fields.
+func DecodeL1Block(storage map[common.Hash]common.Hash) (L1BlockData, error) {
+	numberTimestamp := storage[l1BlockNumberTimestampSlot]
+	if !isZero(numberTimestamp[:16]) {
+		return L1BlockData{}, fmt.Errorf("L1Block slot %s has bits above number and timestamp: %s", l1BlockNumberTimestampSlot, numberTimestamp)
+	}
+	sequenceNumber := storage[l1BlockSequenceNumberSlot]
+	if !isZero(sequenceNumber[:16]) {
+		return L1BlockData{}, fmt.Errorf("L1Block slot %s has bits above sequenceNumber and the fee scalars: %s", l1BlockSequenceNumberSlot, sequenceNumber)
+	}
+	return L1BlockData{
+		Number:            binary.BigEndian.Uint64(numberTimestamp[24:]),
+		Timestamp:         binary.BigEndian.Uint64(numberTimestamp[16:24]),
+		BaseFee:           storage[l1BlockBasefeeSlot].Big(),
+		Hash:              storage[l1BlockHashSlot],
+		SequenceNumber:    binary.BigEndian.Uint64(sequenceNumber[24:]),
+		BatcherHash:       storage[l1BlockBatcherHashSlot],
+		L1FeeOverhead:     storage[l1BlockFeeOverheadSlot].Big(),
+		L1FeeScalar:       storage[l1BlockFeeScalarSlot].Big(),
+		BlobBaseFeeScalar: binary.BigEndian.Uint32(sequenceNumber[20:24]),
+		BaseFeeScalar:     binary.BigEndian.Uint32(sequenceNumber[16:20]),
+		BlobBaseFee:       storage[blobBaseFeeSlot].Big(),
+	}, nil
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package predeploys
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// useEcotoneLayouts registers the named predeploys with the Ecotone storage
+// layout for the duration of the test.
+func useEcotoneLayouts(t *testing.T, names ...string) {
+	restoreRegistry(t)
+	state := SaveState()
+	for _, name := range names {
+		predeploy := *state.predeploys[name]
+		predeploy.StorageLayoutVersion = ecotoneLayoutVersion
+		state.predeploys[name] = &predeploy
+	}
+	RestoreState(state)
+}
+
+func TestReadBlobBaseFee(t *testing.T) {
+	storage := map[common.Hash]common.Hash{
+		BlobBaseFeeSlot(): common.BigToHash(big.NewInt(12345)),
+	}
+
+	// The L1Block and GasPriceOracle of this tree predate Ecotone.
+	_, ok := Predeploys["L1Block"].ReadBlobBaseFee(storage)
+	require.False(t, ok)
+	_, ok = Predeploys["GasPriceOracle"].ReadBlobBaseFee(storage)
+	require.False(t, ok)
+
+	useEcotoneLayouts(t, "L1Block")
+	fee, ok := Predeploys["L1Block"].ReadBlobBaseFee(storage)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(12345), fee)
+	_, ok = Predeploys["L1Block"].ReadBlobBaseFee(map[common.Hash]common.Hash{})
+	require.False(t, ok)
+	_, ok = Predeploys["GasPriceOracle"].ReadBlobBaseFee(storage)
+	require.False(t, ok)
+
+	useEcotoneLayouts(t, "L1Block", "GasPriceOracle")
+	fee, ok = Predeploys["GasPriceOracle"].ReadBlobBaseFee(storage)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(12345), fee)
+	_, ok = Predeploys["L2StandardBridge"].ReadBlobBaseFee(storage)
+	require.False(t, ok)
+}
+
+func TestHasEcotoneLayout(t *testing.T) {
+	for version, want := range map[string]bool{
+		"":       false,
+		"1.1.0":  false,
+		"1.2.0":  true,
+		"1.3.0":  true,
+		"1.10.0": true,
+		"2.0.0":  true,
+		"0.9.9":  false,
+		"1.2":    false,
+	} {
+		require.Equal(t, want, hasEcotoneLayout(&Predeploy{StorageLayoutVersion: version}), version)
+	}
+}
+
+func TestDecodeL1Block(t *testing.T) {
+	var numberTimestamp common.Hash
+	binary.BigEndian.PutUint64(numberTimestamp[24:], 19_000_000)
+	binary.BigEndian.PutUint64(numberTimestamp[16:24], 1_700_000_000)
+	hash := common.HexToHash("0x1234")
+	batcherHash := common.HexToHash("0xba7c4e2")
+	storage := map[common.Hash]common.Hash{
+		common.BigToHash(big.NewInt(0)): numberTimestamp,
+		common.BigToHash(big.NewInt(1)): common.BigToHash(big.NewInt(30_000_000_000)),
+		common.BigToHash(big.NewInt(2)): hash,
+		common.BigToHash(big.NewInt(3)): common.BigToHash(big.NewInt(4)),
+		common.BigToHash(big.NewInt(4)): batcherHash,
+		common.BigToHash(big.NewInt(5)): common.BigToHash(big.NewInt(188)),
+		common.BigToHash(big.NewInt(6)): common.BigToHash(big.NewInt(684_000)),
+	}
+	data, err := DecodeL1Block(storage)
+	require.NoError(t, err)
+	require.Equal(t, L1BlockData{
+		Number:         19_000_000,
+		Timestamp:      1_700_000_000,
+		BaseFee:        big.NewInt(30_000_000_000),
+		Hash:           hash,
+		SequenceNumber: 4,
+		BatcherHash:    batcherHash,
+		L1FeeOverhead:  big.NewInt(188),
+		L1FeeScalar:    big.NewInt(684_000),
+		BlobBaseFee:    common.Hash{}.Big(),
+	}, data)
+
+	t.Run("Ecotone", func(t *testing.T) {
+		var sequenceNumber common.Hash
+		binary.BigEndian.PutUint64(sequenceNumber[24:], 4)
+		binary.BigEndian.PutUint32(sequenceNumber[20:24], 810_949)
+		binary.BigEndian.PutUint32(sequenceNumber[16:20], 1_368)
+		storage[common.BigToHash(big.NewInt(3))] = sequenceNumber
+		storage[common.BigToHash(big.NewInt(7))] = common.BigToHash(big.NewInt(1))
+		data, err := DecodeL1Block(storage)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), data.SequenceNumber)
+		require.Equal(t, uint32(810_949), data.BlobBaseFeeScalar)
+		require.Equal(t, uint32(1_368), data.BaseFeeScalar)
+		require.Equal(t, big.NewInt(1), data.BlobBaseFee)
+	})
+
+	storage[common.BigToHash(big.NewInt(3))] = common.BigToHash(new(big.Int).Lsh(common.Big1, 128))
+	_, err = DecodeL1Block(storage)
+	require.ErrorContains(t, err, "sequenceNumber")
+}
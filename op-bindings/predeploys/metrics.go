@@ -0,0 +1,30 @@
+package predeploys
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterMetrics registers a predeploy_enabled gauge per registered
+// predeploy, set to 1 if it is active for the config and 0 otherwise.
+// It fails if the gauge is already registered with reg.
+func RegisterMetrics(reg prometheus.Registerer, config DeployConfig) error {
+	enabled := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "predeploy_enabled",
+		Help: "Whether the predeploy is enabled (1) or disabled (0)",
+	}, []string{"name"})
+	if err := reg.Register(enabled); err != nil {
+		return fmt.Errorf("failed to register predeploy metrics: %w", err)
+	}
+
+	active := ActivePredeploys(config)
+	for name := range registered() {
+		value := 0.0
+		if _, ok := active[name]; ok {
+			value = 1
+		}
+		enabled.WithLabelValues(name).Set(value)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	require.NoError(t, RegisterMetrics(reg, &testDeployConfig{}))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Equal(t, "predeploy_enabled", families[0].GetName())
+
+	values := make(map[string]float64)
+	for _, metric := range families[0].GetMetric() {
+		values[metric.GetLabel()[0].GetValue()] = metric.GetGauge().GetValue()
+	}
+	require.Len(t, values, len(Predeploys))
+	require.Equal(t, 0.0, values["GovernanceToken"])
+	require.Equal(t, 1.0, values["L2StandardBridge"])
+
+	// Registering twice with the same registerer fails instead of panicking.
+	var already prometheus.AlreadyRegisteredError
+	require.ErrorAs(t, RegisterMetrics(reg, &testDeployConfig{}), &already)
+}
@@ -0,0 +1,98 @@
+package predeploys
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// MigrateStorage collects the storage migrations of all predeploys that are
+// active in the new config, keyed by predeploy address. Predeploys without a
+// Migrate function or without changes are omitted.
+func MigrateStorage(from, to DeployConfig) (map[common.Address]map[common.Hash]common.Hash, error) {
+	migrations := make(map[common.Address]map[common.Hash]common.Hash)
+	for name, predeploy := range ActivePredeploys(to) {
+		if predeploy.Migrate == nil {
+			continue
+		}
+		storage, err := predeploy.Migrate(from, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate %s: %w", name, err)
+		}
+		if len(storage) > 0 {
+			migrations[predeploy.Address] = storage
+		}
+	}
+	return migrations, nil
+}
+
+// GasPriceOracleIsEcotoneSlot is the slot of the isEcotone flag of the
+// Ecotone GasPriceOracle (1.2.0), which switches the L1 fee to the Ecotone
+// formula. Earlier versions of the GasPriceOracle have no storage.
+var GasPriceOracleIsEcotoneSlot = common.BigToHash(common.Big0)
+
+// gasPriceOracleMigrate sets the isEcotone flag of the GasPriceOracle when
+// the upgrade activates Ecotone, as the setEcotone call of the Ecotone
+// network upgrade transactions does.
+func gasPriceOracleMigrate(old, config DeployConfig) (map[common.Hash]common.Hash, error) {
+	ecotoneActive := enabledAtOrAfter(ecotoneTime)
+	if ecotoneActive(old) || !ecotoneActive(config) {
+		return nil, nil
+	}
+	return map[common.Hash]common.Hash{
+		GasPriceOracleIsEcotoneSlot: common.BigToHash(common.Big1),
+	}, nil
+}
+
+// UpgradeStateDiff returns the accounts that change when upgrading the
+// predeploys of a chain in place from the old config and code to the new
+// ones. Code is looked up by predeploy name, and the code of the proxies as
+// "Proxy"; it is an error for an active predeploy to miss code. Slots that
+// a changed account no longer holds are returned with a zero value, so that
+// applying the diff clears them. Accounts of predeploys that are no longer
+// active are returned without code and with all their slots zeroed, so that
+// applying the diff removes them.
+func UpgradeStateDiff(from, to DeployConfig, oldCode, newCode map[string][]byte) (map[common.Address]core.GenesisAccount, error) {
+	before, err := predeployAlloc(from, codeFromMap(oldCode))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build old predeploy state: %w", err)
+	}
+	after, err := predeployAlloc(to, codeFromMap(newCode))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build new predeploy state: %w", err)
+	}
+
+	diff := make(map[common.Address]core.GenesisAccount)
+	for addr, account := range after {
+		old, ok := before[addr]
+		if !ok || !bytes.Equal(old.Code, account.Code) || !maps.Equal(old.Storage, account.Storage) {
+			diff[addr] = clearRemovedSlots(account, old.Storage)
+		}
+	}
+	for addr, old := range before {
+		if _, ok := after[addr]; !ok {
+			diff[addr] = clearRemovedSlots(core.GenesisAccount{Balance: common.Big0}, old.Storage)
+		}
+	}
+	return diff, nil
+}
+
+// clearRemovedSlots returns the account with a zero value for every slot of
+// the old storage that the account does not hold.
+func clearRemovedSlots(account core.GenesisAccount, old map[common.Hash]common.Hash) core.GenesisAccount {
+	storage := maps.Clone(account.Storage)
+	for key := range old {
+		if _, ok := storage[key]; ok {
+			continue
+		}
+		if storage == nil {
+			storage = make(map[common.Hash]common.Hash)
+		}
+		storage[key] = common.Hash{}
+	}
+	account.Storage = storage
+	return account
+}
@@ -0,0 +1,146 @@
+package predeploys
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStorage(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	slot := common.HexToHash("0x01")
+	require.NoError(t, Register("TestMigrate", &Predeploy{
+		Address: addr,
+		Migrate: func(old, config DeployConfig) (map[common.Hash]common.Hash, error) {
+			if old.CanyonTime(0) == nil && config.CanyonTime(0) != nil {
+				return map[common.Hash]common.Hash{slot: common.BigToHash(common.Big1)}, nil
+			}
+			return nil, nil
+		},
+	}))
+
+	migrations, err := MigrateStorage(&testDeployConfig{}, &testDeployConfig{canyonTime: u64(0)})
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]map[common.Hash]common.Hash{
+		addr: {slot: common.BigToHash(common.Big1)},
+	}, migrations)
+
+	migrations, err = MigrateStorage(&testDeployConfig{}, &testDeployConfig{})
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+}
+
+func TestMigrateStorageGasPriceOracleEcotone(t *testing.T) {
+	preEcotone := &forkDeployConfig{testDeployConfig: testDeployConfig{canyonTime: u64(0)}}
+	ecotone := &forkDeployConfig{testDeployConfig: testDeployConfig{canyonTime: u64(0)}, ecotoneTime: u64(0)}
+
+	migrations, err := MigrateStorage(preEcotone, ecotone)
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]map[common.Hash]common.Hash{
+		GasPriceOracleAddr: {GasPriceOracleIsEcotoneSlot: common.BigToHash(common.Big1)},
+	}, migrations)
+
+	// Nothing to migrate once Ecotone is active, or while it is scheduled later.
+	migrations, err = MigrateStorage(ecotone, ecotone)
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+	later := &forkDeployConfig{testDeployConfig: testDeployConfig{canyonTime: u64(0)}, ecotoneTime: u64(100)}
+	migrations, err = MigrateStorage(preEcotone, later)
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+}
+
+func TestMigrateStorageError(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	require.NoError(t, Register("TestMigrate", &Predeploy{
+		Address: addr,
+		Migrate: func(old, config DeployConfig) (map[common.Hash]common.Hash, error) {
+			return nil, errors.New("boom")
+		},
+	}))
+
+	_, err := MigrateStorage(&testDeployConfig{}, &testDeployConfig{})
+	require.ErrorContains(t, err, "TestMigrate")
+}
+
+func TestUpgradeStateDiff(t *testing.T) {
+	config := &testDeployConfig{}
+	oldCode := map[string][]byte{"Proxy": {0xaa}}
+	for name := range ActivePredeploys(config) {
+		oldCode[name] = []byte{0x01}
+	}
+	newCode := make(map[string][]byte, len(oldCode))
+	for name, code := range oldCode {
+		newCode[name] = code
+	}
+	newCode["L1Block"] = []byte{0x02}
+
+	diff, err := UpgradeStateDiff(config, config, oldCode, newCode)
+	require.NoError(t, err)
+	impl, err := ImplementationAddress(L1BlockAddr)
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	require.Equal(t, []byte{0x02}, diff[impl].Code)
+
+	// Enabling Canyon adds the Create2Deployer.
+	newCode["Create2Deployer"] = []byte{0x03}
+	diff, err = UpgradeStateDiff(config, &testDeployConfig{canyonTime: u64(0)}, oldCode, newCode)
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+	require.Equal(t, []byte{0x03}, diff[Create2DeployerAddr].Code)
+
+	delete(newCode, "L1Block")
+	_, err = UpgradeStateDiff(config, config, oldCode, newCode)
+	require.ErrorContains(t, err, "no code for L1Block")
+}
+
+func TestUpgradeStateDiffRemovedSlots(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	kept, removed := common.HexToHash("0x01"), common.HexToHash("0x02")
+	require.NoError(t, Register("TestSeeded", &Predeploy{
+		Address:       addr,
+		ProxyDisabled: true,
+		InitStorage: func(config DeployConfig) map[common.Hash]common.Hash {
+			storage := map[common.Hash]common.Hash{kept: common.HexToHash("0xaa")}
+			if config.(*testDeployConfig).governance {
+				storage[removed] = common.HexToHash("0xbb")
+			}
+			return storage
+		},
+	}))
+	gone := common.HexToAddress("0x42000000000000000000000000000000000000f1")
+	require.NoError(t, Register("TestRemoved", &Predeploy{
+		Address:       gone,
+		ProxyDisabled: true,
+		Enabled: func(config DeployConfig) bool {
+			return config.(*testDeployConfig).governance
+		},
+		InitStorage: func(config DeployConfig) map[common.Hash]common.Hash {
+			return map[common.Hash]common.Hash{kept: common.HexToHash("0xcc")}
+		},
+	}))
+
+	from, to := &testDeployConfig{governance: true}, &testDeployConfig{}
+	code := map[string][]byte{"Proxy": {0xaa}}
+	for name := range ActivePredeploys(from) {
+		code[name] = []byte{0x01}
+	}
+	diff, err := UpgradeStateDiff(from, to, code, code)
+	require.NoError(t, err)
+	require.Equal(t, map[common.Hash]common.Hash{
+		kept:    common.HexToHash("0xaa"),
+		removed: {},
+	}, diff[addr].Storage)
+
+	// The slots of predeploys that are no longer active are zeroed.
+	require.Equal(t, core.GenesisAccount{
+		Storage: map[common.Hash]common.Hash{kept: {}},
+		Balance: common.Big0,
+	}, diff[gone])
+}
@@ -0,0 +1,73 @@
+package predeploys
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Multicall3Config is implemented by deploy configs of chains that predeploy
+// Multicall3 at its canonical address.
+type Multicall3Config interface {
+	Multicall3Enabled() bool
+}
+
+// MulticallRequest is a single call of a Multicall3 aggregate call.
+type MulticallRequest struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// versioned returns the addresses of the registered predeploys whose ABI
+// has a version() function, sorted by address, along with their ABIs.
+func versioned() ([]common.Address, map[common.Address]*abi.ABI) {
+	var addrs []common.Address
+	abis := make(map[common.Address]*abi.ABI)
+	for _, predeploy := range registered() {
+		if predeploy.ABI == nil {
+			continue
+		}
+		parsed := predeploy.ABI()
+		if _, ok := parsed.Methods["version"]; !ok {
+			continue
+		}
+		addrs = append(addrs, predeploy.Address)
+		abis[predeploy.Address] = parsed
+	}
+	sortAddresses(addrs)
+	return addrs, abis
+}
+
+// VersionMulticall returns the calls to version() of every predeploy that
+// exposes it, to be batched in a single Multicall3 aggregate call.
+func VersionMulticall() []MulticallRequest {
+	addrs, abis := versioned()
+	requests := make([]MulticallRequest, 0, len(addrs))
+	for _, addr := range addrs {
+		calldata, err := abis[addr].Pack("version")
+		if err != nil {
+			panic(fmt.Errorf("failed to pack version(): %w", err))
+		}
+		requests = append(requests, MulticallRequest{Target: addr, CallData: calldata})
+	}
+	return requests
+}
+
+// ParseVersionMulticall decodes the return data of the calls built by
+// VersionMulticall, in the same order, into versions keyed by address.
+func ParseVersionMulticall(returns [][]byte) (map[common.Address]string, error) {
+	addrs, abis := versioned()
+	if len(returns) != len(addrs) {
+		return nil, fmt.Errorf("expected %d results, got %d", len(addrs), len(returns))
+	}
+	versions := make(map[common.Address]string, len(addrs))
+	for i, addr := range addrs {
+		out, err := abis[addr].Unpack("version", returns[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode version of %s: %w", addr, err)
+		}
+		versions[addr] = *abi.ConvertType(out[0], new(string)).(*string)
+	}
+	return versions, nil
+}
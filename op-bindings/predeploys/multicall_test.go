@@ -0,0 +1,78 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionMulticall(t *testing.T) {
+	requests := VersionMulticall()
+	require.NotEmpty(t, requests)
+
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	var returns [][]byte
+	var sawBridge bool
+	for _, req := range requests {
+		// version()
+		require.Equal(t, hexutil.MustDecode("0x54fd4d50"), req.CallData)
+		require.NotEqual(t, WETH9Addr, req.Target)
+		sawBridge = sawBridge || req.Target == L2StandardBridgeAddr
+		ret, err := abi.Arguments{{Type: stringType}}.Pack("1.2.3")
+		require.NoError(t, err)
+		returns = append(returns, ret)
+	}
+	require.True(t, sawBridge)
+
+	versions, err := ParseVersionMulticall(returns)
+	require.NoError(t, err)
+	require.Len(t, versions, len(requests))
+	require.Equal(t, "1.2.3", versions[L2StandardBridgeAddr])
+
+	_, err = ParseVersionMulticall(returns[1:])
+	require.Error(t, err)
+	returns[0] = []byte{0x01}
+	_, err = ParseVersionMulticall(returns)
+	require.Error(t, err)
+}
+
+type multicall3DeployConfig struct {
+	testDeployConfig
+	enabled bool
+}
+
+func (c *multicall3DeployConfig) Multicall3Enabled() bool {
+	return c.enabled
+}
+
+func TestMulticall3(t *testing.T) {
+	predeploy := Predeploys["Multicall3"]
+	require.Equal(t, common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"), predeploy.Address)
+	require.Equal(t, predeploy, PredeploysByAddress[Multicall3Addr])
+	require.True(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "Multicall3")
+	require.NotContains(t, ActivePredeploys(&multicall3DeployConfig{}), "Multicall3")
+	require.Contains(t, ActivePredeploys(&multicall3DeployConfig{enabled: true}), "Multicall3")
+}
+
+func TestResolveDeployedBytecode(t *testing.T) {
+	code, err := ResolveDeployedBytecode("Create2Deployer")
+	require.NoError(t, err)
+	require.Equal(t, Create2DeployerCodeHash, crypto.Keccak256Hash(code))
+
+	code, err = ResolveDeployedBytecode("Multicall3")
+	require.NoError(t, err)
+	require.Equal(t, Multicall3CodeHash, crypto.Keccak256Hash(code))
+
+	code, err = ResolveDeployedBytecode("L1Block")
+	require.NoError(t, err)
+	require.NotEmpty(t, code)
+
+	_, err = ResolveDeployedBytecode("L2Faucet")
+	require.ErrorContains(t, err, "no bytecode")
+}
@@ -0,0 +1,85 @@
+package predeploys
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// codeNamespace is the namespace of the implementations of OP Stack predeploys.
+	codeNamespace = common.HexToAddress("0xc0D3C0d3C0d3C0D3c0d3C0d3c0D3C0d3c0d30000")
+	// oasysCodeNamespace is the namespace of the implementations of Oasys predeploys.
+	oasysCodeNamespace = common.HexToAddress("0xC0F6C0f6C0F6C0f6C0f6c0F6C0f6C0F6c0f60000")
+)
+
+// ImplementationAddress derives the address the implementation of a proxied
+// predeploy is deployed at: the last two bytes of the predeploy address in
+// the code namespace of its predeploy namespace.
+func ImplementationAddress(addr common.Address) (common.Address, error) {
+	var impl common.Address
+	switch {
+	case bytes.Equal(addr[0:2], []byte{0x42, 0x00}):
+		impl = codeNamespace
+	case bytes.Equal(addr[0:2], []byte{0x62, 0x00}):
+		impl = oasysCodeNamespace
+	default:
+		return common.Address{}, fmt.Errorf("cannot handle non predeploy: %s", addr)
+	}
+	copy(impl[18:], addr[18:])
+	return impl, nil
+}
+
+// CheckImplementationCollisions derives the implementation address of every
+// proxied predeploy and returns an error if two of them share the same one.
+func CheckImplementationCollisions() error {
+	predeploys := registered()
+	names := make([]string, 0, len(predeploys))
+	for name := range predeploys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	seen := make(map[common.Address]string)
+	for _, name := range names {
+		predeploy := predeploys[name]
+		if predeploy.ProxyDisabled {
+			continue
+		}
+		impl, err := ImplementationAddress(predeploy.Address)
+		if err != nil {
+			return fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		if other, ok := seen[impl]; ok {
+			return fmt.Errorf("predeploys %s and %s share implementation address %s", other, name, impl)
+		}
+		seen[impl] = name
+	}
+	return nil
+}
+
+// maxPrecompileAddress is the upper bound of the range reserved for
+// precompiles. Ethereum uses 0x01 to 0x0a as of Cancun, and the range up to
+// 0xff is kept free for the precompiles of future forks.
+var maxPrecompileAddress = common.HexToAddress("0x00000000000000000000000000000000000000ff")
+
+// CheckPrecompileCollisions returns an error if a registered predeploy is
+// placed at the zero address or in the range reserved for precompiles.
+func CheckPrecompileCollisions() error {
+	predeploys := registered()
+	names := make([]string, 0, len(predeploys))
+	for name := range predeploys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		addr := predeploys[name].Address
+		if bytes.Compare(addr[:], maxPrecompileAddress[:]) <= 0 {
+			return fmt.Errorf("predeploy %s at %s collides with the precompile range", name, addr)
+		}
+	}
+	return nil
+}
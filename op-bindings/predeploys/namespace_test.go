@@ -0,0 +1,44 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementationAddress(t *testing.T) {
+	impl, err := ImplementationAddress(L2StandardBridgeAddr)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xc0d3c0d3c0d3c0d3c0d3c0d3c0d3c0d3c0d30010"), impl)
+
+	impl, err = ImplementationAddress(OasysL2ERC721BridgeAddr)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xc0f6c0f6c0f6c0f6c0f6c0f6c0f6c0f6c0f60001"), impl)
+
+	_, err = ImplementationAddress(Create2DeployerAddr)
+	require.Error(t, err)
+}
+
+func TestCheckImplementationCollisions(t *testing.T) {
+	restoreRegistry(t)
+	require.NoError(t, CheckImplementationCollisions())
+
+	a := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	b := common.HexToAddress("0x42000000000000000000000000000000ff0000f0")
+	require.NoError(t, Register("CollisionA", &Predeploy{Address: a}))
+	require.NoError(t, Register("CollisionB", &Predeploy{Address: b}))
+
+	require.ErrorContains(t, CheckImplementationCollisions(), "CollisionA and CollisionB")
+}
+
+func TestCheckPrecompileCollisions(t *testing.T) {
+	restoreRegistry(t)
+	require.NoError(t, CheckPrecompileCollisions())
+
+	require.NoError(t, Register("TestPrecompile", &Predeploy{
+		Address:       common.HexToAddress("0x0000000000000000000000000000000000000009"),
+		ProxyDisabled: true,
+	}))
+	require.ErrorContains(t, CheckPrecompileCollisions(), "predeploy TestPrecompile at 0x0000000000000000000000000000000000000009 collides")
+}
@@ -0,0 +1,199 @@
+package predeploys
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GasFreeConfig is implemented by deploy configs of verses that support
+// gas-free transactions for allowlisted contracts.
+type GasFreeConfig interface {
+	GasFreeEnabled() bool
+	GasFreeAllowlist() []common.Address
+}
+
+// gasFreeAllowlistStorage seeds the `mapping(address => bool)` at slot 0 of
+// the OasysGasFreeAllowlist with the configured addresses.
+func gasFreeAllowlistStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[GasFreeConfig](config)
+	if !ok {
+		return storage
+	}
+	for _, addr := range c.GasFreeAllowlist() {
+		storage[mappingSlot(common.BytesToHash(addr.Bytes()), 0)] = common.BigToHash(common.Big1)
+	}
+	return storage
+}
+
+// GovernanceParamsConfig is implemented by deploy configs of verses that
+// publish their governance parameters on-chain.
+type GovernanceParamsConfig interface {
+	GovernanceQuorum() *big.Int
+	ProposalThreshold() *big.Int
+}
+
+var (
+	// GovernanceQuorumSlot is the OasysGovernanceParams slot holding the quorum.
+	GovernanceQuorumSlot = common.BigToHash(common.Big0)
+	// ProposalThresholdSlot is the OasysGovernanceParams slot holding the proposal threshold.
+	ProposalThresholdSlot = common.BigToHash(common.Big1)
+)
+
+// governanceParamsStorage seeds the OasysGovernanceParams with the quorum
+// and proposal threshold of the config.
+func governanceParamsStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[GovernanceParamsConfig](config)
+	if !ok {
+		return storage
+	}
+	if quorum := c.GovernanceQuorum(); quorum != nil {
+		storage[GovernanceQuorumSlot] = common.BigToHash(quorum)
+	}
+	if threshold := c.ProposalThreshold(); threshold != nil {
+		storage[ProposalThresholdSlot] = common.BigToHash(threshold)
+	}
+	return storage
+}
+
+// RewardSplit is the share of block rewards paid to a recipient, in basis points.
+type RewardSplit struct {
+	Recipient common.Address
+	Bps       uint16
+}
+
+// BlockRewardSplitConfig is implemented by deploy configs of verses that
+// split block rewards between several recipients.
+type BlockRewardSplitConfig interface {
+	BlockRewardSplitEnabled() bool
+	BlockRewardSplits() []RewardSplit
+}
+
+// blockRewardSplitStorage seeds the OasysBlockRewardSplitter with the split
+// recipients in the `address[]` at slot 0 and their basis points in the
+// `uint256[]` at slot 1.
+func blockRewardSplitStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[BlockRewardSplitConfig](config)
+	if !ok {
+		return storage
+	}
+	splits := c.BlockRewardSplits()
+	length := common.BigToHash(new(big.Int).SetInt64(int64(len(splits))))
+	storage[common.BigToHash(common.Big0)] = length
+	storage[common.BigToHash(common.Big1)] = length
+	for i, split := range splits {
+		storage[arraySlot(0, uint64(i))] = common.BytesToHash(split.Recipient.Bytes())
+		storage[arraySlot(1, uint64(i))] = common.BigToHash(new(big.Int).SetUint64(uint64(split.Bps)))
+	}
+	return storage
+}
+
+// CustomPrecompile is a precompile added by a verse and its gas cost.
+type CustomPrecompile struct {
+	Address common.Address
+	Gas     uint64
+}
+
+// CustomPrecompileConfig is implemented by deploy configs of verses that add
+// custom precompiles.
+type CustomPrecompileConfig interface {
+	CustomPrecompilesEnabled() bool
+	CustomPrecompiles() []CustomPrecompile
+}
+
+// precompileRegistryStorage seeds the OasysPrecompileRegistry with the
+// precompile addresses in the `address[]` at slot 0 and their gas costs in
+// the `mapping(address => uint256)` at slot 1.
+func precompileRegistryStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[CustomPrecompileConfig](config)
+	if !ok {
+		return storage
+	}
+	precompiles := c.CustomPrecompiles()
+	storage[common.BigToHash(common.Big0)] = common.BigToHash(new(big.Int).SetInt64(int64(len(precompiles))))
+	for i, precompile := range precompiles {
+		storage[arraySlot(0, uint64(i))] = common.BytesToHash(precompile.Address.Bytes())
+		storage[mappingSlot(common.BytesToHash(precompile.Address.Bytes()), 1)] = common.BigToHash(new(big.Int).SetUint64(precompile.Gas))
+	}
+	return storage
+}
+
+// PriceOracleConfig is implemented by deploy configs of verses that bundle
+// a price oracle aggregating several feeds.
+type PriceOracleConfig interface {
+	PriceOracleEnabled() bool
+	PriceFeeds() []common.Address
+}
+
+// priceOracleStorage seeds the OasysPriceOracle with the feed addresses in
+// the `address[]` at slot 0.
+func priceOracleStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[PriceOracleConfig](config)
+	if !ok {
+		return storage
+	}
+	feeds := c.PriceFeeds()
+	storage[common.BigToHash(common.Big0)] = common.BigToHash(new(big.Int).SetInt64(int64(len(feeds))))
+	for i, feed := range feeds {
+		storage[arraySlot(0, uint64(i))] = common.BytesToHash(feed.Bytes())
+	}
+	return storage
+}
+
+// BridgeRateLimitConfig is implemented by deploy configs of verses that put
+// a rate limiter in front of the L2StandardBridge.
+type BridgeRateLimitConfig interface {
+	BridgeRateLimitEnabled() bool
+	// BridgeRateLimitCap is the amount, in wei, that may be bridged per window.
+	BridgeRateLimitCap() *big.Int
+}
+
+var (
+	// BridgeRateLimiterBridgeSlot is the OasysBridgeRateLimiter slot holding
+	// the bridge it guards.
+	BridgeRateLimiterBridgeSlot = common.BigToHash(common.Big0)
+	// BridgeRateLimiterCapSlot is the OasysBridgeRateLimiter slot holding the
+	// per-window cap in wei.
+	BridgeRateLimiterCapSlot = common.BigToHash(common.Big1)
+)
+
+// bridgeRateLimiterStorage seeds the OasysBridgeRateLimiter with the
+// L2StandardBridge and the per-window cap.
+func bridgeRateLimiterStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[BridgeRateLimitConfig](config)
+	if !ok {
+		return storage
+	}
+	storage[BridgeRateLimiterBridgeSlot] = common.BytesToHash(L2StandardBridgeAddr.Bytes())
+	if limit := c.BridgeRateLimitCap(); limit != nil {
+		storage[BridgeRateLimiterCapSlot] = common.BigToHash(limit)
+	}
+	return storage
+}
+
+// BlocklistConfig is implemented by deploy configs of verses whose bridge
+// refuses to serve blocked addresses.
+type BlocklistConfig interface {
+	BlocklistEnabled() bool
+	BlockedAddresses() []common.Address
+}
+
+// blocklistStorage seeds the `mapping(address => bool)` at slot 0 of the
+// OasysAddressBlocklist with the initially blocked addresses.
+func blocklistStorage(config DeployConfig) map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash)
+	c, ok := configAs[BlocklistConfig](config)
+	if !ok {
+		return storage
+	}
+	for _, addr := range c.BlockedAddresses() {
+		storage[mappingSlot(common.BytesToHash(addr.Bytes()), 0)] = common.BigToHash(common.Big1)
+	}
+	return storage
+}
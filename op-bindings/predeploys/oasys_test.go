@@ -0,0 +1,249 @@
+package predeploys
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type gasFreeDeployConfig struct {
+	testDeployConfig
+	enabled   bool
+	allowlist []common.Address
+}
+
+func (c *gasFreeDeployConfig) GasFreeEnabled() bool {
+	return c.enabled
+}
+
+func (c *gasFreeDeployConfig) GasFreeAllowlist() []common.Address {
+	return c.allowlist
+}
+
+func TestOasysGasFreeAllowlist(t *testing.T) {
+	predeploy := Predeploys["OasysGasFreeAllowlist"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysGasFreeAllowlistAddr])
+	require.False(t, predeploy.ProxyDisabled)
+
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "OasysGasFreeAllowlist")
+	require.NotContains(t, ActivePredeploys(&gasFreeDeployConfig{}), "OasysGasFreeAllowlist")
+
+	a, b := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	config := &gasFreeDeployConfig{enabled: true, allowlist: []common.Address{a, b}}
+	require.Contains(t, ActivePredeploys(config), "OasysGasFreeAllowlist")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysGasFreeAllowlist has no bytecode")
+
+	storage := predeploy.InitStorage(config)
+	require.Len(t, storage, 2)
+	for _, addr := range []common.Address{a, b} {
+		slot := crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), make([]byte, 32))
+		require.Equal(t, common.BigToHash(common.Big1), storage[slot])
+	}
+}
+
+type governanceParamsDeployConfig struct {
+	testDeployConfig
+	quorum, threshold *big.Int
+}
+
+func (c *governanceParamsDeployConfig) GovernanceQuorum() *big.Int {
+	return c.quorum
+}
+
+func (c *governanceParamsDeployConfig) ProposalThreshold() *big.Int {
+	return c.threshold
+}
+
+func TestOasysGovernanceParams(t *testing.T) {
+	predeploy := Predeploys["OasysGovernanceParams"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysGovernanceParamsAddr])
+	require.False(t, predeploy.ProxyDisabled)
+
+	config := &governanceParamsDeployConfig{quorum: big.NewInt(400), threshold: big.NewInt(1e18)}
+	require.NotContains(t, ActivePredeploys(config), "OasysGovernanceParams")
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{governance: true}), "OasysGovernanceParams")
+
+	config.governance = true
+	require.Contains(t, ActivePredeploys(config), "OasysGovernanceParams")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysGovernanceParams has no bytecode")
+	require.Equal(t, map[common.Hash]common.Hash{
+		GovernanceQuorumSlot:  common.BigToHash(big.NewInt(400)),
+		ProposalThresholdSlot: common.BigToHash(big.NewInt(1e18)),
+	}, predeploy.InitStorage(config))
+}
+
+type rewardSplitDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	splits  []RewardSplit
+}
+
+func (c *rewardSplitDeployConfig) BlockRewardSplitEnabled() bool {
+	return c.enabled
+}
+
+func (c *rewardSplitDeployConfig) BlockRewardSplits() []RewardSplit {
+	return c.splits
+}
+
+func TestOasysBlockRewardSplitter(t *testing.T) {
+	predeploy := Predeploys["OasysBlockRewardSplitter"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysBlockRewardSplitterAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&rewardSplitDeployConfig{}), "OasysBlockRewardSplitter")
+
+	a, b := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	config := &rewardSplitDeployConfig{enabled: true, splits: []RewardSplit{{a, 7000}, {b, 3000}}}
+	require.Contains(t, ActivePredeploys(config), "OasysBlockRewardSplitter")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysBlockRewardSplitter has no bytecode")
+
+	recipients := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(common.Big0).Bytes()))
+	bps := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(common.Big1).Bytes()))
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.BigToHash(common.Big0):                               common.BigToHash(common.Big2),
+		common.BigToHash(common.Big1):                               common.BigToHash(common.Big2),
+		common.BigToHash(recipients):                                common.BytesToHash(a.Bytes()),
+		common.BigToHash(new(big.Int).Add(recipients, common.Big1)): common.BytesToHash(b.Bytes()),
+		common.BigToHash(bps):                                       common.BigToHash(big.NewInt(7000)),
+		common.BigToHash(new(big.Int).Add(bps, common.Big1)):        common.BigToHash(big.NewInt(3000)),
+	}, predeploy.InitStorage(config))
+}
+
+type precompileDeployConfig struct {
+	testDeployConfig
+	enabled     bool
+	precompiles []CustomPrecompile
+}
+
+func (c *precompileDeployConfig) CustomPrecompilesEnabled() bool {
+	return c.enabled
+}
+
+func (c *precompileDeployConfig) CustomPrecompiles() []CustomPrecompile {
+	return c.precompiles
+}
+
+func TestOasysPrecompileRegistry(t *testing.T) {
+	predeploy := Predeploys["OasysPrecompileRegistry"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysPrecompileRegistryAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&precompileDeployConfig{}), "OasysPrecompileRegistry")
+
+	a, b := common.HexToAddress("0x0100"), common.HexToAddress("0x0101")
+	config := &precompileDeployConfig{enabled: true, precompiles: []CustomPrecompile{{a, 3000}, {b, 6000}}}
+	require.Contains(t, ActivePredeploys(config), "OasysPrecompileRegistry")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysPrecompileRegistry has no bytecode")
+
+	addresses := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(common.Big0).Bytes()))
+	gasSlot := func(addr common.Address) common.Hash {
+		return crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), common.BigToHash(common.Big1).Bytes())
+	}
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.BigToHash(common.Big0):                              common.BigToHash(common.Big2),
+		common.BigToHash(addresses):                                common.BytesToHash(a.Bytes()),
+		common.BigToHash(new(big.Int).Add(addresses, common.Big1)): common.BytesToHash(b.Bytes()),
+		gasSlot(a): common.BigToHash(big.NewInt(3000)),
+		gasSlot(b): common.BigToHash(big.NewInt(6000)),
+	}, predeploy.InitStorage(config))
+}
+
+type priceOracleDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	feeds   []common.Address
+}
+
+func (c *priceOracleDeployConfig) PriceOracleEnabled() bool {
+	return c.enabled
+}
+
+func (c *priceOracleDeployConfig) PriceFeeds() []common.Address {
+	return c.feeds
+}
+
+func TestOasysPriceOracle(t *testing.T) {
+	predeploy := Predeploys["OasysPriceOracle"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysPriceOracleAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "OasysPriceOracle")
+	require.NotContains(t, ActivePredeploys(&priceOracleDeployConfig{}), "OasysPriceOracle")
+
+	a, b := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	config := &priceOracleDeployConfig{enabled: true, feeds: []common.Address{a, b}}
+	require.Contains(t, ActivePredeploys(config), "OasysPriceOracle")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysPriceOracle has no bytecode")
+
+	feeds := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(common.Big0).Bytes()))
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.BigToHash(common.Big0):                          common.BigToHash(common.Big2),
+		common.BigToHash(feeds):                                common.BytesToHash(a.Bytes()),
+		common.BigToHash(new(big.Int).Add(feeds, common.Big1)): common.BytesToHash(b.Bytes()),
+	}, predeploy.InitStorage(config))
+}
+
+type bridgeRateLimitDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	limit   *big.Int
+}
+
+func (c *bridgeRateLimitDeployConfig) BridgeRateLimitEnabled() bool {
+	return c.enabled
+}
+
+func (c *bridgeRateLimitDeployConfig) BridgeRateLimitCap() *big.Int {
+	return c.limit
+}
+
+func TestOasysBridgeRateLimiter(t *testing.T) {
+	predeploy := Predeploys["OasysBridgeRateLimiter"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysBridgeRateLimiterAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "OasysBridgeRateLimiter")
+	require.NotContains(t, ActivePredeploys(&bridgeRateLimitDeployConfig{}), "OasysBridgeRateLimiter")
+
+	config := &bridgeRateLimitDeployConfig{enabled: true, limit: big.NewInt(1e18)}
+	require.Contains(t, ActivePredeploys(config), "OasysBridgeRateLimiter")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysBridgeRateLimiter has no bytecode")
+	require.Equal(t, map[common.Hash]common.Hash{
+		BridgeRateLimiterBridgeSlot: common.BytesToHash(L2StandardBridgeAddr.Bytes()),
+		BridgeRateLimiterCapSlot:    common.BigToHash(big.NewInt(1e18)),
+	}, predeploy.InitStorage(config))
+}
+
+type blocklistDeployConfig struct {
+	testDeployConfig
+	enabled bool
+	blocked []common.Address
+}
+
+func (c *blocklistDeployConfig) BlocklistEnabled() bool {
+	return c.enabled
+}
+
+func (c *blocklistDeployConfig) BlockedAddresses() []common.Address {
+	return c.blocked
+}
+
+func TestOasysAddressBlocklist(t *testing.T) {
+	predeploy := Predeploys["OasysAddressBlocklist"]
+	require.Equal(t, predeploy, PredeploysByAddress[OasysAddressBlocklistAddr])
+	require.False(t, predeploy.ProxyDisabled)
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "OasysAddressBlocklist")
+	require.NotContains(t, ActivePredeploys(&blocklistDeployConfig{}), "OasysAddressBlocklist")
+
+	a, b := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	config := &blocklistDeployConfig{enabled: true, blocked: []common.Address{a, b}}
+	require.Contains(t, ActivePredeploys(config), "OasysAddressBlocklist")
+	require.ErrorContains(t, ValidateBytecode(config), "predeploy OasysAddressBlocklist has no bytecode")
+
+	storage := predeploy.InitStorage(config)
+	require.Len(t, storage, 2)
+	for _, addr := range []common.Address{a, b} {
+		slot := crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), make([]byte, 32))
+		require.Equal(t, common.BigToHash(common.Big1), storage[slot])
+	}
+}
@@ -1,16 +1,373 @@
 package predeploys
 
 import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 type DeployConfig interface {
 	GovernanceEnabled() bool
 	CanyonTime(genesisTime uint64) *uint64
+	ForkSchedule() ForkSchedule
+}
+
+// TimestampedConfig is a DeployConfig that evaluates predeploy enablement
+// as of a specific L2 timestamp, relative to the L2 genesis, rather than at
+// genesis itself.
+type TimestampedConfig interface {
+	DeployConfig
+	Timestamp() uint64
+}
+
+// WrappedConfig is implemented by deploy configs that wrap another config to
+// override some of its methods. Optional config interfaces and fields are
+// looked up through the wrapped configs.
+type WrappedConfig interface {
+	Unwrap() DeployConfig
+}
+
+// configAs returns the config as a T, looking through wrapped configs.
+func configAs[T any](config DeployConfig) (T, bool) {
+	for {
+		if c, ok := config.(T); ok {
+			return c, true
+		}
+		w, ok := config.(WrappedConfig)
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		config = w.Unwrap()
+	}
+}
+
+// evaluationTime returns the L2 timestamp, relative to genesis, at which
+// enablement is evaluated for the config.
+func evaluationTime(config DeployConfig) uint64 {
+	if tc, ok := configAs[TimestampedConfig](config); ok {
+		return tc.Timestamp()
+	}
+	return 0
 }
 
 type Predeploy struct {
 	Address       common.Address
 	ProxyDisabled bool
 	Enabled       func(config DeployConfig) bool
+	// EnabledField is the name of a boolean field of the concrete deploy
+	// config that enables the predeploy. It is an alternative to Enabled.
+	EnabledField string
+	// ActivationTime returns the L2 timestamp, relative to genesis, from
+	// which the predeploy is active, or nil if it is never activated.
+	// Predeploys without it are active from genesis.
+	ActivationTime func(config DeployConfig) *uint64
+	// ABI lazily parses the ABI of the predeploy. It is nil when no ABI is known.
+	ABI func() *abi.ABI
+	// InitStorage returns the storage to seed at the predeploy address in the genesis.
+	InitStorage func(config DeployConfig) map[common.Hash]common.Hash
+	// GenesisBalance returns the ETH balance, in wei, the predeploy holds at
+	// genesis. Predeploys without it start with no balance.
+	GenesisBalance func(config DeployConfig) *big.Int
+	// Migrate returns the storage to rewrite at the predeploy address when
+	// upgrading a chain in place from the old config to the new one.
+	Migrate func(old, config DeployConfig) (map[common.Hash]common.Hash, error)
+	// InitCalldata returns the calldata of the call that initializes the
+	// predeploy after deployment, if any.
+	InitCalldata func(config DeployConfig) ([]byte, error)
+	// InitGas is the gas limit of the init call. DefaultInitGas is used when zero.
+	InitGas uint64
+	// InitDependsOn lists the names of predeploys that must be initialized first.
+	InitDependsOn []string
+	// InitPriority orders init calls that do not depend on each other.
+	// Lower priorities are initialized first.
+	InitPriority int
+	// UpgradeDelay is the timelock delay between queueing and executing an
+	// upgrade of the predeploy. It is zero for predeploys without a timelock.
+	UpgradeDelay time.Duration
+	// Version is the semver of the contract deployed for the predeploy, as
+	// returned by its version() function. It is empty when unknown.
+	Version string
+	// StorageLayoutVersion is the version of the contract whose storage
+	// layout the predeploy uses, for tools decoding its state. It is empty
+	// when unknown.
+	StorageLayoutVersion string
+	// ConfigFields lists the deploy config methods that Enabled,
+	// InitStorage and GenesisBalance read, for impact analysis of config
+	// changes. EnabledField is implied and need not be repeated.
+	ConfigFields []string
+	// MutuallyExclusive lists the names of predeploys that must not be active
+	// on the same chain as this one.
+	MutuallyExclusive []string
+}
+
+// ActivePredeploys returns the predeploys that are enabled for the given config,
+// keyed by name. Overrides set with SetEnabledOverride take precedence over
+// the Enabled function of each predeploy.
+func ActivePredeploys(config DeployConfig) map[string]*Predeploy {
+	return activePredeploys(config, evaluationTime(config))
+}
+
+// ActiveAtTimestamp returns the predeploys that are enabled for the given
+// config as of the L2 timestamp, expressed in seconds after L2 genesis.
+func ActiveAtTimestamp(config DeployConfig, timestamp uint64) map[string]*Predeploy {
+	return activePredeploys(config, timestamp)
+}
+
+// activePredeploys returns the predeploys that are enabled for the config
+// as of the L2 timestamp. The config is passed through unchanged, so that
+// Enabled functions see its optional interfaces and fields.
+func activePredeploys(config DeployConfig, timestamp uint64) map[string]*Predeploy {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return activePredeploysLocked(config, timestamp)
+}
+
+// activePredeploysLocked is activePredeploys for callers holding registryMu.
+func activePredeploysLocked(config DeployConfig, timestamp uint64) map[string]*Predeploy {
+	active := make(map[string]*Predeploy)
+	for name, predeploy := range Predeploys {
+		if !isEnabled(name, predeploy, config, timestamp) {
+			continue
+		}
+		active[name] = predeploy
+	}
+	return active
+}
+
+// EnablementMatrix returns a table of the enablement of every registered
+// predeploy at genesis and after each of the forks, whose activation times
+// are given in seconds after L2 genesis. The first row is the header
+// ("predeploy", "genesis", then the fork names ordered by activation time),
+// and each following row holds the name of a predeploy, sorted, and
+// "true" or "false" per column.
+func EnablementMatrix(config DeployConfig, forkTimes map[string]uint64) [][]string {
+	forks := make([]string, 0, len(forkTimes))
+	for fork := range forkTimes {
+		forks = append(forks, fork)
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		if forkTimes[forks[i]] != forkTimes[forks[j]] {
+			return forkTimes[forks[i]] < forkTimes[forks[j]]
+		}
+		return forks[i] < forks[j]
+	})
+
+	header := append([]string{"predeploy", "genesis"}, forks...)
+	columns := []map[string]*Predeploy{ActiveAtTimestamp(config, 0)}
+	for _, fork := range forks {
+		columns = append(columns, ActiveAtTimestamp(config, forkTimes[fork]))
+	}
+
+	registryMu.RLock()
+	names := make([]string, 0, len(Predeploys))
+	for name := range Predeploys {
+		names = append(names, name)
+	}
+	registryMu.RUnlock()
+	sort.Strings(names)
+
+	rows := [][]string{header}
+	for _, name := range names {
+		row := []string{name}
+		for _, active := range columns {
+			_, ok := active[name]
+			row = append(row, strconv.FormatBool(ok))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ValidateMutualExclusion returns an error if two mutually exclusive
+// predeploys are both active for the config.
+func ValidateMutualExclusion(config DeployConfig) error {
+	active := ActivePredeploys(config)
+	names := make([]string, 0, len(active))
+	for name := range active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, other := range active[name].MutuallyExclusive {
+			if _, ok := active[other]; ok {
+				return fmt.Errorf("predeploys %s and %s are mutually exclusive", name, other)
+			}
+		}
+	}
+	return nil
+}
+
+// LabelMap returns the names of the active predeploys keyed by address,
+// covering both the OP Stack and the Oasys namespaces. It is suitable for
+// annotating addresses in tracer output.
+func LabelMap(config DeployConfig) map[common.Address]string {
+	labels := make(map[common.Address]string)
+	for name, predeploy := range ActivePredeploys(config) {
+		labels[predeploy.Address] = name
+	}
+	return labels
+}
+
+// ResolvePrefix returns the predeploy whose name starts with the prefix.
+// An exact name match always wins; otherwise the prefix must be unambiguous.
+func ResolvePrefix(prefix string) (string, *Predeploy, error) {
+	predeploys := registered()
+	if predeploy, ok := predeploys[prefix]; ok {
+		return prefix, predeploy, nil
+	}
+	var candidates []string
+	for name := range predeploys {
+		if strings.HasPrefix(name, prefix) {
+			candidates = append(candidates, name)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return "", nil, fmt.Errorf("no predeploy matches %q", prefix)
+	case 1:
+		return candidates[0], predeploys[candidates[0]], nil
+	default:
+		sort.Strings(candidates)
+		return "", nil, fmt.Errorf("ambiguous predeploy %q, candidates: %s", prefix, strings.Join(candidates, ", "))
+	}
+}
+
+// IsProxiedPredeploy reports whether addr is a registered predeploy that sits
+// behind a proxy. Only those predeploys can be upgraded.
+func IsProxiedPredeploy(addr common.Address) bool {
+	predeploy, ok := lookupAddress(addr)
+	return ok && !predeploy.ProxyDisabled
+}
+
+// PartitionByProxy splits the active predeploys into those behind a proxy
+// and those deployed directly. Both groups are sorted by address.
+func PartitionByProxy(config DeployConfig) (proxied, direct []*Predeploy) {
+	for _, predeploy := range ActivePredeploys(config) {
+		if predeploy.ProxyDisabled {
+			direct = append(direct, predeploy)
+		} else {
+			proxied = append(proxied, predeploy)
+		}
+	}
+	byAddress := func(predeploys []*Predeploy) func(i, j int) bool {
+		return func(i, j int) bool {
+			return bytes.Compare(predeploys[i].Address[:], predeploys[j].Address[:]) < 0
+		}
+	}
+	sort.Slice(proxied, byAddress(proxied))
+	sort.Slice(direct, byAddress(direct))
+	return proxied, direct
+}
+
+// UpgradeDelayFor returns the upgrade timelock delay of the named predeploy.
+// It returns false if the predeploy is unknown or has no upgrade delay.
+func UpgradeDelayFor(name string) (time.Duration, bool) {
+	predeploy, ok := lookup(name)
+	if !ok || predeploy.UpgradeDelay == 0 {
+		return 0, false
+	}
+	return predeploy.UpgradeDelay, true
+}
+
+// CAIP10Identifiers returns the CAIP-10 account identifiers of the active
+// predeploys on the chain, e.g. "eip155:10:0x4200...0010", keyed by name.
+func CAIP10Identifiers(chainID uint64, config DeployConfig) map[string]string {
+	ids := make(map[string]string)
+	for name, predeploy := range ActivePredeploys(config) {
+		ids[name] = fmt.Sprintf("eip155:%d:%s", chainID, predeploy.Address.Hex())
+	}
+	return ids
+}
+
+// AsAccounts returns the active predeploys as accounts, sorted by address,
+// for use with wallet and keystore tooling.
+func AsAccounts(config DeployConfig) []accounts.Account {
+	active := ActivePredeploys(config)
+	accts := make([]accounts.Account, 0, len(active))
+	for _, predeploy := range active {
+		accts = append(accts, accounts.Account{Address: predeploy.Address})
+	}
+	sort.Slice(accts, func(i, j int) bool {
+		return bytes.Compare(accts[i].Address[:], accts[j].Address[:]) < 0
+	})
+	return accts
+}
+
+// StorageLayoutVersions returns the storage layout version of each
+// registered predeploy that declares one, keyed by name.
+func StorageLayoutVersions() map[string]string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	versions := make(map[string]string)
+	for name, predeploy := range Predeploys {
+		if predeploy.StorageLayoutVersion != "" {
+			versions[name] = predeploy.StorageLayoutVersion
+		}
+	}
+	return versions
+}
+
+// AffectedByConfigField returns the sorted names of the registered
+// predeploys whose enablement or genesis state depends on the named deploy
+// config field or method.
+func AffectedByConfigField(field string) []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	var names []string
+	for name, predeploy := range Predeploys {
+		if predeploy.EnabledField == field || slices.Contains(predeploy.ConfigFields, field) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TotalGenesisBalance returns the sum of the genesis balances of the active
+// predeploys, in wei.
+func TotalGenesisBalance(config DeployConfig) *big.Int {
+	total := new(big.Int)
+	for _, predeploy := range ActivePredeploys(config) {
+		total.Add(total, genesisBalance(predeploy, config))
+	}
+	return total
+}
+
+// genesisBalance returns the genesis balance of the predeploy, or zero if
+// it does not declare one.
+func genesisBalance(predeploy *Predeploy, config DeployConfig) *big.Int {
+	if predeploy.GenesisBalance == nil {
+		return new(big.Int)
+	}
+	if balance := predeploy.GenesisBalance(config); balance != nil {
+		return balance
+	}
+	return new(big.Int)
+}
+
+// NegotiateVersions compares the predeploy versions reported by a peer,
+// keyed by name, with ours. Predeploys without a known version on either
+// side are ignored. The conflicting names are returned sorted.
+func NegotiateVersions(peer map[string]string) (compatible bool, conflicts []string) {
+	for name, version := range peer {
+		predeploy, ok := lookup(name)
+		if !ok || predeploy.Version == "" || version == "" {
+			continue
+		}
+		if predeploy.Version != version {
+			conflicts = append(conflicts, name)
+		}
+	}
+	sort.Strings(conflicts)
+	return len(conflicts) == 0, conflicts
 }
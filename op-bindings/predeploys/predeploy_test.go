@@ -0,0 +1,261 @@
+package predeploys
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testDeployConfig is a minimal DeployConfig for tests.
+type testDeployConfig struct {
+	governance bool
+	canyonTime *uint64
+}
+
+func (c *testDeployConfig) GovernanceEnabled() bool {
+	return c.governance
+}
+
+func (c *testDeployConfig) CanyonTime(genesisTime uint64) *uint64 {
+	return c.canyonTime
+}
+
+func (c *testDeployConfig) ForkSchedule() ForkSchedule {
+	return ForkSchedule{CanyonTime: c.canyonTime}
+}
+
+func u64(v uint64) *uint64 {
+	return &v
+}
+
+func TestActivePredeploys(t *testing.T) {
+	active := ActivePredeploys(&testDeployConfig{})
+	require.Contains(t, active, "L2StandardBridge")
+	require.NotContains(t, active, "GovernanceToken")
+	require.NotContains(t, active, "Create2Deployer")
+
+	active = ActivePredeploys(&testDeployConfig{governance: true, canyonTime: u64(0)})
+	require.Contains(t, active, "GovernanceToken")
+	require.Contains(t, active, "Create2Deployer")
+}
+
+func TestLabelMap(t *testing.T) {
+	labels := LabelMap(&testDeployConfig{})
+	require.Equal(t, "L2StandardBridge", labels[L2StandardBridgeAddr])
+	require.Equal(t, "OasysL2ERC721Bridge", labels[OasysL2ERC721BridgeAddr])
+	require.NotContains(t, labels, GovernanceTokenAddr)
+}
+
+func TestActiveAtTimestamp(t *testing.T) {
+	config := &testDeployConfig{canyonTime: u64(100)}
+	require.NotContains(t, ActivePredeploys(config), "Create2Deployer")
+	require.NotContains(t, ActiveAtTimestamp(config, 99), "Create2Deployer")
+	require.Contains(t, ActiveAtTimestamp(config, 100), "Create2Deployer")
+	require.Contains(t, ActiveAtTimestamp(config, 101), "Create2Deployer")
+	require.Contains(t, ActiveAtTimestamp(config, 101), "L2StandardBridge")
+
+	require.NotContains(t, ActiveAtTimestamp(&testDeployConfig{}, 1000), "Create2Deployer")
+}
+
+// timedDeployConfig schedules Ecotone and has an enablement field.
+type timedDeployConfig struct {
+	forkDeployConfig
+	EnableTestPredeploy bool
+}
+
+func TestActiveAtTimestampOptionalConfig(t *testing.T) {
+	restoreRegistry(t)
+	require.NoError(t, Register("TestField", &Predeploy{
+		Address:      common.HexToAddress("0x42000000000000000000000000000000000000f0"),
+		EnabledField: "EnableTestPredeploy",
+	}))
+	require.NoError(t, Register("TestEcotone", &Predeploy{
+		Address: common.HexToAddress("0x42000000000000000000000000000000000000f1"),
+		ActivationTime: func(config DeployConfig) *uint64 {
+			return config.ForkSchedule().EcotoneTime
+		},
+	}))
+	config := &timedDeployConfig{
+		forkDeployConfig: forkDeployConfig{
+			testDeployConfig: testDeployConfig{canyonTime: u64(100)},
+			scheduleEnabled:  true,
+			ecotoneTime:      u64(200),
+		},
+		EnableTestPredeploy: true,
+	}
+
+	active := ActiveAtTimestamp(config, 0)
+	require.Equal(t, ActivePredeploys(config), active)
+	require.Contains(t, active, "L2ForkSchedule")
+	require.Contains(t, active, "TestField")
+	require.NotContains(t, active, "TestEcotone")
+	active = ActiveAtTimestamp(config, 200)
+	require.Contains(t, active, "L2ForkSchedule")
+	require.Contains(t, active, "TestField")
+	require.Contains(t, active, "TestEcotone")
+
+	rows := make(map[string][]string)
+	for _, row := range EnablementMatrix(config, map[string]uint64{"canyon": 100, "ecotone": 200})[1:] {
+		rows[row[0]] = row[1:]
+	}
+	require.Equal(t, []string{"true", "true", "true"}, rows["L2ForkSchedule"])
+	require.Equal(t, []string{"true", "true", "true"}, rows["TestField"])
+	require.Equal(t, []string{"false", "false", "true"}, rows["TestEcotone"])
+
+	added, removed, _ := PredeployChangesBetweenForks(config, "canyon", "ecotone")
+	require.Equal(t, []string{"TestEcotone"}, added)
+	require.Empty(t, removed)
+}
+
+func TestResolvePrefix(t *testing.T) {
+	name, predeploy, err := ResolvePrefix("L2St")
+	require.NoError(t, err)
+	require.Equal(t, "L2StandardBridge", name)
+	require.Equal(t, L2StandardBridgeAddr, predeploy.Address)
+
+	name, _, err = ResolvePrefix("L1Block")
+	require.NoError(t, err)
+	require.Equal(t, "L1Block", name)
+
+	_, _, err = ResolvePrefix("L2")
+	require.ErrorContains(t, err, "ambiguous")
+	require.ErrorContains(t, err, "L2CrossDomainMessenger, ")
+	require.ErrorContains(t, err, "L2StandardBridge, ")
+
+	_, _, err = ResolvePrefix("Nope")
+	require.ErrorContains(t, err, "no predeploy")
+}
+
+func TestIsProxiedPredeploy(t *testing.T) {
+	require.True(t, IsProxiedPredeploy(L2StandardBridgeAddr))
+	require.True(t, IsProxiedPredeploy(OasysL2ERC721BridgeAddr))
+	require.False(t, IsProxiedPredeploy(WETH9Addr))
+	require.False(t, IsProxiedPredeploy(GovernanceTokenAddr))
+	require.False(t, IsProxiedPredeploy(common.HexToAddress("0x1234")))
+}
+
+func TestPartitionByProxy(t *testing.T) {
+	proxied, direct := PartitionByProxy(&testDeployConfig{governance: true, canyonTime: u64(0)})
+	require.ElementsMatch(t, []*Predeploy{
+		Predeploys["WETH9"],
+		Predeploys["GovernanceToken"],
+		Predeploys["Create2Deployer"],
+	}, direct)
+	require.Contains(t, proxied, Predeploys["L2StandardBridge"])
+	require.Contains(t, proxied, Predeploys["OasysL2ERC721Bridge"])
+	require.Len(t, ActivePredeploys(&testDeployConfig{governance: true, canyonTime: u64(0)}), len(proxied)+len(direct))
+}
+
+func TestUpgradeDelayFor(t *testing.T) {
+	for _, name := range []string{"L2StandardBridge", "OasysL2ERC721Bridge", "L2CrossDomainMessenger"} {
+		delay, ok := UpgradeDelayFor(name)
+		require.True(t, ok, name)
+		require.Equal(t, BridgeUpgradeDelay, delay, name)
+	}
+	_, ok := UpgradeDelayFor("WETH9")
+	require.False(t, ok)
+	_, ok = UpgradeDelayFor("Unknown")
+	require.False(t, ok)
+}
+
+func TestCAIP10Identifiers(t *testing.T) {
+	ids := CAIP10Identifiers(248, &testDeployConfig{})
+	require.Equal(t, "eip155:248:0x4200000000000000000000000000000000000010", ids["L2StandardBridge"])
+	require.Equal(t, "eip155:248:0x6200000000000000000000000000000000000001", ids["OasysL2ERC721Bridge"])
+	require.NotContains(t, ids, "GovernanceToken")
+	require.Len(t, ids, len(ActivePredeploys(&testDeployConfig{})))
+}
+
+func TestAsAccounts(t *testing.T) {
+	config := &testDeployConfig{governance: true}
+	accts := AsAccounts(config)
+	require.Len(t, accts, len(ActivePredeploys(config)))
+	require.Equal(t, LegacyMessagePasserAddr, accts[0].Address)
+	for _, acct := range accts {
+		require.Contains(t, PredeploysByAddress, acct.Address)
+	}
+}
+
+func TestStorageLayoutVersions(t *testing.T) {
+	versions := StorageLayoutVersions()
+	require.Equal(t, "1.1.0", versions["GasPriceOracle"])
+	require.Contains(t, versions, "L2CrossDomainMessenger")
+	require.NotContains(t, versions, "WETH9")
+}
+
+func TestNegotiateVersions(t *testing.T) {
+	compatible, conflicts := NegotiateVersions(map[string]string{
+		"L2CrossDomainMessenger": Predeploys["L2CrossDomainMessenger"].Version,
+		"Unknown":                "9.9.9",
+	})
+	require.True(t, compatible)
+	require.Empty(t, conflicts)
+
+	compatible, conflicts = NegotiateVersions(map[string]string{
+		"L2CrossDomainMessenger": Predeploys["L2CrossDomainMessenger"].Version,
+		"L2StandardBridge":       "0.0.1",
+	})
+	require.False(t, compatible)
+	require.Equal(t, []string{"L2StandardBridge"}, conflicts)
+}
+
+func TestEnablementMatrix(t *testing.T) {
+	config := &testDeployConfig{canyonTime: u64(100)}
+	matrix := EnablementMatrix(config, map[string]uint64{"canyon": 100, "regolith": 0})
+	require.Equal(t, []string{"predeploy", "genesis", "regolith", "canyon"}, matrix[0])
+	require.Len(t, matrix, len(Predeploys)+1)
+
+	rows := make(map[string][]string)
+	for _, row := range matrix[1:] {
+		rows[row[0]] = row[1:]
+	}
+	require.Equal(t, []string{"false", "false", "true"}, rows["Create2Deployer"])
+	require.Equal(t, []string{"true", "true", "true"}, rows["L2StandardBridge"])
+	require.Equal(t, []string{"false", "false", "false"}, rows["GovernanceToken"])
+}
+
+func TestTotalGenesisBalance(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{}
+	require.Equal(t, new(big.Int), TotalGenesisBalance(config))
+
+	require.NoError(t, Register("TestBalance", &Predeploy{
+		Address: common.HexToAddress("0x42000000000000000000000000000000000000f0"),
+		GenesisBalance: func(config DeployConfig) *big.Int {
+			return big.NewInt(1e18)
+		},
+	}))
+	require.NoError(t, Register("TestNoBalance", &Predeploy{
+		Address: common.HexToAddress("0x42000000000000000000000000000000000000f1"),
+		GenesisBalance: func(config DeployConfig) *big.Int {
+			return nil
+		},
+	}))
+	require.Equal(t, big.NewInt(1e18), TotalGenesisBalance(config))
+
+	genesis, err := DevGenesis(config)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1e18), genesis.Alloc[common.HexToAddress("0x42000000000000000000000000000000000000f0")].Balance)
+}
+
+func TestAffectedByConfigField(t *testing.T) {
+	affected := AffectedByConfigField("GovernanceEnabled")
+	require.Contains(t, affected, "GovernanceToken")
+	require.Contains(t, affected, "OasysGovernanceParams")
+	require.NotContains(t, affected, "L2StandardBridge")
+	require.True(t, sort.StringsAreSorted(affected))
+
+	require.Equal(t, []string{"L2Faucet"}, AffectedByConfigField("FaucetDripAmount"))
+	require.Empty(t, AffectedByConfigField("Unknown"))
+
+	restoreRegistry(t)
+	require.NoError(t, Register("TestGated", &Predeploy{
+		Address:      common.HexToAddress("0x42000000000000000000000000000000000000f0"),
+		EnabledField: "TestEnabled",
+	}))
+	require.Equal(t, []string{"TestGated"}, AffectedByConfigField("TestEnabled"))
+}
@@ -0,0 +1,60 @@
+// Package predeploystest provides assertions on the predeploy registry for
+// the tests of packages that define or configure predeploys.
+package predeploystest
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AssertEnabledPurity asserts that the Enabled function of every predeploy
+// is free of side effects: evaluating it twice for the config gives the same
+// result and leaves the registry unchanged.
+func AssertEnabledPurity(t testing.TB, config predeploys.DeployConfig) {
+	t.Helper()
+	before := predeploys.SaveState().Predeploys()
+	for name, predeploy := range before {
+		if predeploy.Enabled == nil {
+			continue
+		}
+		first, second := predeploy.Enabled(config), predeploy.Enabled(config)
+		if first != second {
+			t.Errorf("Enabled of predeploy %s is not pure: got %v, then %v", name, first, second)
+		}
+	}
+	after := predeploys.SaveState().Predeploys()
+	if len(before) != len(after) {
+		t.Errorf("Enabled functions mutated the registry")
+	}
+	for name, predeploy := range before {
+		if after[name] != predeploy {
+			t.Errorf("Enabled functions mutated the registry entry of %s", name)
+		}
+	}
+}
+
+// AssertAddressesStable asserts that every predeploy active for more than one
+// of the configs has the same address in each of them, and that no address
+// is used by different predeploys across the configs. The addresses are read
+// right after evaluating the active set of each config, so addresses that
+// depend on the config are caught.
+func AssertAddressesStable(t testing.TB, configs ...predeploys.DeployConfig) {
+	t.Helper()
+	addresses := make(map[string]common.Address)
+	names := make(map[common.Address]string)
+	for _, config := range configs {
+		for name, predeploy := range predeploys.ActivePredeploys(config) {
+			addr := predeploy.Address
+			if prev, ok := addresses[name]; ok && prev != addr {
+				t.Errorf("predeploy %s moved from %s to %s", name, prev, addr)
+			}
+			if prev, ok := names[addr]; ok && prev != name {
+				t.Errorf("address %s moved from predeploy %s to %s", addr, prev, name)
+			}
+			addresses[name] = addr
+			names[addr] = name
+		}
+	}
+}
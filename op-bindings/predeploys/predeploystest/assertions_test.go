@@ -0,0 +1,113 @@
+package predeploystest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeployConfig struct {
+	governance bool
+	canyonTime *uint64
+}
+
+func (c *testDeployConfig) GovernanceEnabled() bool {
+	return c.governance
+}
+
+func (c *testDeployConfig) CanyonTime(genesisTime uint64) *uint64 {
+	return c.canyonTime
+}
+
+func (c *testDeployConfig) ForkSchedule() predeploys.ForkSchedule {
+	return predeploys.ForkSchedule{CanyonTime: c.canyonTime}
+}
+
+func u64(v uint64) *uint64 {
+	return &v
+}
+
+// recordingTB records failures instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertEnabledPurity(t *testing.T) {
+	AssertEnabledPurity(t, &testDeployConfig{})
+	AssertEnabledPurity(t, &testDeployConfig{governance: true, canyonTime: u64(0)})
+}
+
+func TestAssertEnabledPurityImpure(t *testing.T) {
+	state := predeploys.SaveState()
+	t.Cleanup(func() { predeploys.RestoreState(state) })
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	var calls int
+	require.NoError(t, predeploys.Register("TestImpure", &predeploys.Predeploy{
+		Address: addr,
+		Enabled: func(config predeploys.DeployConfig) bool {
+			calls++
+			return calls%2 == 1
+		},
+	}))
+
+	tb := &recordingTB{TB: t}
+	AssertEnabledPurity(tb, &testDeployConfig{})
+	require.Len(t, tb.errors, 1)
+	require.Contains(t, tb.errors[0], "TestImpure")
+}
+
+func TestAssertAddressesStable(t *testing.T) {
+	AssertAddressesStable(t,
+		&testDeployConfig{},
+		&testDeployConfig{governance: true},
+		&testDeployConfig{governance: true, canyonTime: u64(0)},
+	)
+
+	tb := &recordingTB{TB: t}
+	AssertAddressesStable(tb, &testDeployConfig{}, &testDeployConfig{governance: true})
+	require.Empty(t, tb.errors)
+}
+
+func TestAssertAddressesStableMoved(t *testing.T) {
+	state := predeploys.SaveState()
+	t.Cleanup(func() { predeploys.RestoreState(state) })
+	base := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	governance := common.HexToAddress("0x42000000000000000000000000000000000000f1")
+	// The address of the predeploy depends on the config it is enabled for.
+	moving := &predeploys.Predeploy{Address: base}
+	moving.Enabled = func(config predeploys.DeployConfig) bool {
+		moving.Address = base
+		if config.(*testDeployConfig).governance {
+			moving.Address = governance
+		}
+		return true
+	}
+	require.NoError(t, predeploys.Register("TestMoving", moving))
+
+	tb := &recordingTB{TB: t}
+	AssertAddressesStable(tb, &testDeployConfig{}, &testDeployConfig{governance: true})
+	require.Len(t, tb.errors, 1)
+	require.Contains(t, tb.errors[0], "predeploy TestMoving moved")
+
+	// Another predeploy taking over the address is caught as well.
+	require.NoError(t, predeploys.Register("TestSquatter", &predeploys.Predeploy{
+		Address: governance,
+		Enabled: func(config predeploys.DeployConfig) bool {
+			return !config.(*testDeployConfig).governance
+		},
+	}))
+	tb = &recordingTB{TB: t}
+	AssertAddressesStable(tb, &testDeployConfig{}, &testDeployConfig{governance: true})
+	require.Len(t, tb.errors, 2)
+	require.Contains(t, tb.errors, fmt.Sprintf("address %s moved from predeploy TestSquatter to TestMoving", governance))
+}
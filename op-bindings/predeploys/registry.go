@@ -0,0 +1,391 @@
+package predeploys
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrRegistryFrozen is returned when mutating the predeploy registry after Freeze.
+var ErrRegistryFrozen = errors.New("predeploy registry is frozen")
+
+var (
+	registryMu sync.RWMutex
+	frozen     bool
+	// registryGeneration is incremented on every change of the registry or
+	// its overrides, so that results derived from an older state are not
+	// reused.
+	registryGeneration uint64
+	enabledOverrides   = make(map[string]bool)
+	listeners          []func(event ChangeEvent)
+)
+
+// ChangeKind is the kind of a change of the registry.
+type ChangeKind int
+
+const (
+	// ChangeRegistered is a predeploy added with Register.
+	ChangeRegistered ChangeKind = iota
+	// ChangeOverrideSet is an enablement override set with SetEnabledOverride
+	// or LoadEnablementOverrides.
+	ChangeOverrideSet
+	// ChangeOverridesCleared is the removal of all enablement overrides. Its
+	// event has no name.
+	ChangeOverridesCleared
+)
+
+// ChangeEvent describes a change of the registry.
+type ChangeEvent struct {
+	Name string
+	Kind ChangeKind
+}
+
+// OnChange registers a listener that is called after every change of the
+// registry. Listeners are called in registration order, without holding the
+// registry lock, so they may read the registry.
+func OnChange(fn func(event ChangeEvent)) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	listeners = append(listeners, fn)
+}
+
+// notifyChange calls the listeners with the events. The caller must not
+// hold registryMu.
+func notifyChange(events []ChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	registryMu.RLock()
+	fns := append([]func(event ChangeEvent){}, listeners...)
+	registryMu.RUnlock()
+	for _, event := range events {
+		for _, fn := range fns {
+			fn(event)
+		}
+	}
+}
+
+// Register adds a predeploy to the registry under the given name.
+// The name and address must not already be registered.
+func Register(name string, predeploy *Predeploy) error {
+	var events []ChangeEvent
+	defer func() { notifyChange(events) }()
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if frozen {
+		return ErrRegistryFrozen
+	}
+	if _, ok := Predeploys[name]; ok {
+		return fmt.Errorf("predeploy %s already registered", name)
+	}
+	if _, ok := PredeploysByAddress[predeploy.Address]; ok {
+		return fmt.Errorf("predeploy address %s already registered", predeploy.Address)
+	}
+	if err := checkEnabledField(name, predeploy); err != nil {
+		return err
+	}
+	Predeploys[name] = predeploy
+	PredeploysByAddress[predeploy.Address] = predeploy
+	registryGeneration++
+	events = append(events, ChangeEvent{Name: name, Kind: ChangeRegistered})
+	return nil
+}
+
+// SetEnabledOverride forces the predeploy with the given name to be enabled
+// or disabled, regardless of its Enabled function.
+func SetEnabledOverride(name string, enabled bool) error {
+	var events []ChangeEvent
+	defer func() { notifyChange(events) }()
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if frozen {
+		return ErrRegistryFrozen
+	}
+	if _, ok := Predeploys[name]; !ok {
+		return fmt.Errorf("unknown predeploy %s", name)
+	}
+	enabledOverrides[name] = enabled
+	registryGeneration++
+	events = append(events, ChangeEvent{Name: name, Kind: ChangeOverrideSet})
+	return nil
+}
+
+// LoadEnablementOverrides reads a JSON object mapping predeploy names to
+// booleans, e.g. {"GovernanceToken": false, "EAS": true}, and applies each
+// entry as an enablement override. No override is applied if any entry is
+// invalid.
+func LoadEnablementOverrides(r io.Reader) error {
+	var overrides map[string]bool
+	if err := json.NewDecoder(r).Decode(&overrides); err != nil {
+		return fmt.Errorf("failed to decode enablement overrides: %w", err)
+	}
+
+	var events []ChangeEvent
+	defer func() { notifyChange(events) }()
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if frozen {
+		return ErrRegistryFrozen
+	}
+	for name := range overrides {
+		if _, ok := Predeploys[name]; !ok {
+			return fmt.Errorf("unknown predeploy %s", name)
+		}
+	}
+	names := make([]string, 0, len(overrides))
+	for name, enabled := range overrides {
+		enabledOverrides[name] = enabled
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		events = append(events, ChangeEvent{Name: name, Kind: ChangeOverrideSet})
+	}
+	registryGeneration++
+	return nil
+}
+
+// ClearEnablementOverrides removes all enablement overrides.
+func ClearEnablementOverrides() error {
+	var events []ChangeEvent
+	defer func() { notifyChange(events) }()
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if frozen {
+		return ErrRegistryFrozen
+	}
+	enabledOverrides = make(map[string]bool)
+	registryGeneration++
+	events = append(events, ChangeEvent{Kind: ChangeOverridesCleared})
+	return nil
+}
+
+// Freeze prevents any further mutation of the registry. Tools should call it
+// once the deploy config is locked, e.g. after the genesis has been generated.
+func Freeze() {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	frozen = true
+	registryGeneration++
+}
+
+// IsFrozen reports whether Freeze has been called.
+func IsFrozen() bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return frozen
+}
+
+// lookup returns the predeploy registered under the name.
+func lookup(name string) (*Predeploy, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	predeploy, ok := Predeploys[name]
+	return predeploy, ok
+}
+
+// lookupAddress returns the predeploy registered at the address.
+func lookupAddress(addr common.Address) (*Predeploy, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	predeploy, ok := PredeploysByAddress[addr]
+	return predeploy, ok
+}
+
+// registered returns a copy of the registry, keyed by name, that callers
+// can iterate over without holding registryMu.
+func registered() map[string]*Predeploy {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return copyPredeploys(Predeploys)
+}
+
+// isEnabled reports whether the predeploy is enabled for the config as of
+// the L2 timestamp, taking overrides into account. The caller must hold
+// registryMu.
+func isEnabled(name string, predeploy *Predeploy, config DeployConfig, timestamp uint64) bool {
+	if enabled, ok := enabledOverrides[name]; ok {
+		return enabled
+	}
+	if predeploy.EnabledField != "" {
+		enabled, err := configBoolField(config, predeploy.EnabledField)
+		if err != nil || !enabled {
+			return false
+		}
+	} else if predeploy.Enabled != nil && !predeploy.Enabled(config) {
+		return false
+	}
+	if predeploy.ActivationTime == nil {
+		return true
+	}
+	activation := predeploy.ActivationTime(config)
+	return activation != nil && *activation <= timestamp
+}
+
+// configBoolField returns the value of the named boolean field of the
+// struct underlying the config, looking through wrapped configs.
+func configBoolField(config DeployConfig, field string) (bool, error) {
+	v := reflect.Indirect(reflect.ValueOf(config))
+	if v.Kind() != reflect.Struct {
+		if w, ok := config.(WrappedConfig); ok {
+			return configBoolField(w.Unwrap(), field)
+		}
+		return false, fmt.Errorf("deploy config %T is not a struct", config)
+	}
+	f := v.FieldByName(field)
+	if !f.IsValid() {
+		if w, ok := config.(WrappedConfig); ok {
+			return configBoolField(w.Unwrap(), field)
+		}
+		return false, fmt.Errorf("deploy config %T has no field %s", config, field)
+	}
+	if f.Kind() != reflect.Bool {
+		return false, fmt.Errorf("field %s of deploy config %T is not a bool", field, config)
+	}
+	return f.Bool(), nil
+}
+
+// checkEnabledField validates the EnabledField of a predeploy independently
+// of any deploy config.
+func checkEnabledField(name string, predeploy *Predeploy) error {
+	if predeploy.EnabledField == "" {
+		return nil
+	}
+	if predeploy.Enabled != nil {
+		return fmt.Errorf("predeploy %s sets both Enabled and EnabledField", name)
+	}
+	if !token.IsExported(predeploy.EnabledField) {
+		return fmt.Errorf("predeploy %s has invalid EnabledField %q", name, predeploy.EnabledField)
+	}
+	return nil
+}
+
+// ValidateEnabledFields checks that the EnabledField of every predeploy
+// refers to a boolean field of the concrete deploy config.
+func ValidateEnabledFields(config DeployConfig) error {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for name, predeploy := range Predeploys {
+		if predeploy.EnabledField == "" {
+			continue
+		}
+		if _, err := configBoolField(config, predeploy.EnabledField); err != nil {
+			return fmt.Errorf("predeploy %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// RegistryState is a copy of the predeploy registry, including overrides
+// and change listeners.
+type RegistryState struct {
+	predeploys map[string]*Predeploy
+	overrides  map[string]bool
+	frozen     bool
+	listeners  []func(event ChangeEvent)
+}
+
+// SaveState captures the current state of the registry. It is meant for
+// tests that mutate the registry and restore it afterwards with RestoreState.
+func SaveState() RegistryState {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	overrides := make(map[string]bool, len(enabledOverrides))
+	for name, enabled := range enabledOverrides {
+		overrides[name] = enabled
+	}
+	return RegistryState{
+		predeploys: copyPredeploys(Predeploys),
+		overrides:  overrides,
+		frozen:     frozen,
+		listeners:  append([]func(event ChangeEvent){}, listeners...),
+	}
+}
+
+// Predeploys returns a copy of the predeploys of the state, keyed by name.
+func (s RegistryState) Predeploys() map[string]*Predeploy {
+	return copyPredeploys(s.predeploys)
+}
+
+// RestoreState resets the registry to a state captured by SaveState.
+// It also lifts a freeze that happened after the state was saved.
+func RestoreState(state RegistryState) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for name := range Predeploys {
+		delete(Predeploys, name)
+	}
+	for addr := range PredeploysByAddress {
+		delete(PredeploysByAddress, addr)
+	}
+	for name, predeploy := range state.predeploys {
+		Predeploys[name] = predeploy
+		PredeploysByAddress[predeploy.Address] = predeploy
+	}
+	enabledOverrides = make(map[string]bool, len(state.overrides))
+	for name, enabled := range state.overrides {
+		enabledOverrides[name] = enabled
+	}
+	frozen = state.frozen
+	listeners = append([]func(event ChangeEvent){}, state.listeners...)
+	registryGeneration++
+}
+
+// MergeStrategy decides which predeploy Merge keeps when both registries
+// define the same name.
+type MergeStrategy int
+
+const (
+	// OverlayWins keeps the predeploy of the overlay.
+	OverlayWins MergeStrategy = iota
+	// BaseWins keeps the predeploy of the base.
+	BaseWins
+	// ErrorOnConflict fails the merge.
+	ErrorOnConflict
+)
+
+// Merge combines two predeploy registries into a new one, resolving name
+// conflicts with the strategy. Neither input is modified. Merging fails if
+// two predeploys with different names end up sharing an address.
+func Merge(base, overlay map[string]*Predeploy, strategy MergeStrategy) (map[string]*Predeploy, error) {
+	merged := make(map[string]*Predeploy, len(base)+len(overlay))
+	for name, predeploy := range base {
+		merged[name] = predeploy
+	}
+	for name, predeploy := range overlay {
+		if _, ok := merged[name]; ok {
+			switch strategy {
+			case OverlayWins:
+			case BaseWins:
+				continue
+			case ErrorOnConflict:
+				return nil, fmt.Errorf("predeploy %s defined in both registries", name)
+			default:
+				return nil, fmt.Errorf("unknown merge strategy %d", strategy)
+			}
+		}
+		merged[name] = predeploy
+	}
+
+	names := make([]string, 0, len(merged))
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	byAddress := make(map[common.Address]string, len(merged))
+	for _, name := range names {
+		addr := merged[name].Address
+		if other, ok := byAddress[addr]; ok {
+			return nil, fmt.Errorf("predeploys %s and %s share address %s", other, name, addr)
+		}
+		byAddress[addr] = name
+	}
+	return merged, nil
+}
@@ -0,0 +1,188 @@
+package predeploys
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// restoreRegistry restores the state of the registry when the test ends.
+func restoreRegistry(t testing.TB) {
+	state := SaveState()
+	t.Cleanup(func() { RestoreState(state) })
+}
+
+func TestRegister(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000ff")
+	require.NoError(t, Register("TestPredeploy", &Predeploy{Address: addr}))
+
+	require.Equal(t, addr, Predeploys["TestPredeploy"].Address)
+	require.Contains(t, PredeploysByAddress, addr)
+	require.Error(t, Register("TestPredeploy", &Predeploy{Address: common.Address{1}}))
+	require.Error(t, Register("Other", &Predeploy{Address: addr}))
+}
+
+func TestSetEnabledOverride(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{}
+	require.NoError(t, SetEnabledOverride("GovernanceToken", true))
+	require.Contains(t, ActivePredeploys(config), "GovernanceToken")
+
+	require.Error(t, SetEnabledOverride("Unknown", true))
+}
+
+func TestFreeze(t *testing.T) {
+	restoreRegistry(t)
+	require.False(t, IsFrozen())
+	Freeze()
+	require.True(t, IsFrozen())
+
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000ff")
+	require.ErrorIs(t, Register("TestPredeploy", &Predeploy{Address: addr}), ErrRegistryFrozen)
+	require.ErrorIs(t, SetEnabledOverride("GovernanceToken", true), ErrRegistryFrozen)
+	require.NotContains(t, Predeploys, "TestPredeploy")
+
+	// Reads keep working once frozen.
+	require.Contains(t, ActivePredeploys(&testDeployConfig{}), "L2StandardBridge")
+}
+
+func TestLoadEnablementOverrides(t *testing.T) {
+	restoreRegistry(t)
+	config := &testDeployConfig{governance: true}
+	require.Contains(t, ActivePredeploys(config), "GovernanceToken")
+
+	err := LoadEnablementOverrides(strings.NewReader(`{"GovernanceToken": false, "EAS": true}`))
+	require.NoError(t, err)
+	active := ActivePredeploys(config)
+	require.NotContains(t, active, "GovernanceToken")
+	require.Contains(t, active, "EAS")
+
+	require.NoError(t, ClearEnablementOverrides())
+	require.Contains(t, ActivePredeploys(config), "GovernanceToken")
+
+	require.Error(t, LoadEnablementOverrides(strings.NewReader(`{"Unknown": true}`)))
+	require.Error(t, LoadEnablementOverrides(strings.NewReader(`{"EAS": "yes"}`)))
+	require.Error(t, LoadEnablementOverrides(strings.NewReader(`{"EAS": false, "Unknown": true}`)))
+	require.Contains(t, ActivePredeploys(config), "EAS")
+}
+
+func TestSaveRestoreState(t *testing.T) {
+	config := &testDeployConfig{}
+	want := ActivePredeploys(config)
+	state := SaveState()
+
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000ff")
+	require.NoError(t, Register("TestPredeploy", &Predeploy{Address: addr}))
+	require.NoError(t, SetEnabledOverride("L1Block", false))
+	Freeze()
+
+	RestoreState(state)
+	require.False(t, IsFrozen())
+	require.NotContains(t, Predeploys, "TestPredeploy")
+	require.NotContains(t, PredeploysByAddress, addr)
+	require.Equal(t, want, ActivePredeploys(config))
+	require.Equal(t, want, CachedActive(config))
+}
+
+type fieldDeployConfig struct {
+	testDeployConfig
+	EnableTestPredeploy bool
+	NotABool            string
+}
+
+func TestEnabledField(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000ff")
+	require.NoError(t, Register("TestPredeploy", &Predeploy{Address: addr, EnabledField: "EnableTestPredeploy"}))
+
+	require.NotContains(t, ActivePredeploys(&fieldDeployConfig{}), "TestPredeploy")
+	require.Contains(t, ActivePredeploys(&fieldDeployConfig{EnableTestPredeploy: true}), "TestPredeploy")
+	require.NoError(t, ValidateEnabledFields(&fieldDeployConfig{}))
+	// Fields are looked up through wrapped configs.
+	wrapped := &governanceConfig{DeployConfig: &fieldDeployConfig{EnableTestPredeploy: true}}
+	require.Contains(t, ActivePredeploys(wrapped), "TestPredeploy")
+
+	// Configs without the field never enable the predeploy.
+	require.NotContains(t, ActivePredeploys(&testDeployConfig{}), "TestPredeploy")
+	require.ErrorContains(t, ValidateEnabledFields(&testDeployConfig{}), "has no field EnableTestPredeploy")
+}
+
+func TestEnabledFieldInvalid(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000ff")
+	require.ErrorContains(t, Register("TestPredeploy", &Predeploy{
+		Address:      addr,
+		EnabledField: "EnableTestPredeploy",
+		Enabled:      func(DeployConfig) bool { return true },
+	}), "both Enabled and EnabledField")
+	require.ErrorContains(t, Register("TestPredeploy", &Predeploy{Address: addr, EnabledField: "enabled"}), "invalid EnabledField")
+
+	require.NoError(t, Register("TestPredeploy", &Predeploy{Address: addr, EnabledField: "NotABool"}))
+	require.NotContains(t, ActivePredeploys(&fieldDeployConfig{NotABool: "true"}), "TestPredeploy")
+	require.ErrorContains(t, ValidateEnabledFields(&fieldDeployConfig{}), "is not a bool")
+}
+
+func TestMerge(t *testing.T) {
+	baseBridge := &Predeploy{Address: common.HexToAddress("0x4200000000000000000000000000000000000010")}
+	overlayBridge := &Predeploy{Address: common.HexToAddress("0x6200000000000000000000000000000000000001")}
+	block := &Predeploy{Address: common.HexToAddress("0x4200000000000000000000000000000000000015")}
+	base := map[string]*Predeploy{"Bridge": baseBridge, "L1Block": block}
+	overlay := map[string]*Predeploy{"Bridge": overlayBridge}
+
+	merged, err := Merge(base, overlay, OverlayWins)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*Predeploy{"Bridge": overlayBridge, "L1Block": block}, merged)
+
+	merged, err = Merge(base, overlay, BaseWins)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*Predeploy{"Bridge": baseBridge, "L1Block": block}, merged)
+
+	_, err = Merge(base, overlay, ErrorOnConflict)
+	require.ErrorContains(t, err, "predeploy Bridge defined in both registries")
+
+	// The inputs are left untouched.
+	require.Equal(t, baseBridge, base["Bridge"])
+	require.Len(t, overlay, 1)
+}
+
+func TestMergeAddressConflict(t *testing.T) {
+	base := map[string]*Predeploy{"A": {Address: common.HexToAddress("0x01")}}
+	overlay := map[string]*Predeploy{"B": {Address: common.HexToAddress("0x01")}}
+	_, err := Merge(base, overlay, OverlayWins)
+	require.ErrorContains(t, err, "predeploys A and B share address")
+}
+
+func TestOnChange(t *testing.T) {
+	restoreRegistry(t)
+	var first, second []ChangeEvent
+	OnChange(func(event ChangeEvent) {
+		// Listeners may read the registry.
+		_ = ActivePredeploys(&testDeployConfig{})
+		first = append(first, event)
+	})
+	OnChange(func(event ChangeEvent) {
+		second = append(second, event)
+	})
+
+	require.NoError(t, SetEnabledOverride("GovernanceToken", true))
+	require.Equal(t, []ChangeEvent{{Name: "GovernanceToken", Kind: ChangeOverrideSet}}, first)
+
+	require.NoError(t, Register("TestChange", &Predeploy{Address: common.HexToAddress("0x42000000000000000000000000000000000000f0")}))
+	require.NoError(t, LoadEnablementOverrides(strings.NewReader(`{"EAS": false, "L1Block": true}`)))
+	require.NoError(t, ClearEnablementOverrides())
+	// Failed changes are not notified.
+	require.Error(t, SetEnabledOverride("Unknown", true))
+
+	expected := []ChangeEvent{
+		{Name: "GovernanceToken", Kind: ChangeOverrideSet},
+		{Name: "TestChange", Kind: ChangeRegistered},
+		{Name: "EAS", Kind: ChangeOverrideSet},
+		{Name: "L1Block", Kind: ChangeOverrideSet},
+		{Kind: ChangeOverridesCleared},
+	}
+	require.Equal(t, expected, first)
+	require.Equal(t, expected, second)
+}
@@ -0,0 +1,181 @@
+package predeploys
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SystemTxTargets returns the predeploys that are expected as targets of
+// system transactions derived by the rollup node. Every L2 block starts
+// with a deposit to L1Block. Fees are credited to the vaults by the state
+// transition, not by transactions, so the vaults are not targets.
+func SystemTxTargets() []common.Address {
+	return []common.Address{L1BlockAddr}
+}
+
+// WrappedNative returns the address of the wrapped native token, WETH9.
+// WETH9 is not proxied: its code is set at genesis and cannot be upgraded.
+func WrappedNative() common.Address {
+	return WETH9Addr
+}
+
+// DeployerWhitelistConfig is implemented by deploy configs of legacy chains
+// that still enforce the DeployerWhitelist.
+type DeployerWhitelistConfig interface {
+	DeployerWhitelistEnabled() bool
+}
+
+// DeploymentGate returns the address of the DeployerWhitelist, the legacy
+// predeploy that restricted contract deployment, and whether it is enforced
+// for the config. The whitelist is deprecated and only legacy chains
+// enforce it; on other chains anyone can deploy contracts.
+func DeploymentGate(config DeployConfig) (common.Address, bool) {
+	c, ok := configAs[DeployerWhitelistConfig](config)
+	return DeployerWhitelistAddr, ok && c.DeployerWhitelistEnabled()
+}
+
+// MandatoryPredeploys returns the names of the predeploys that must be
+// present on every chain, regardless of the deploy config.
+func MandatoryPredeploys() []string {
+	return []string{
+		"L2CrossDomainMessenger",
+		"L2ToL1MessagePasser",
+		"LegacyMessagePasser",
+		"WETH9",
+		"GasPriceOracle",
+		"L1Block",
+		"ProxyAdmin",
+		"L2StandardBridge",
+		"OasysL2ERC721Bridge",
+		"SequencerFeeVault",
+		"BaseFeeVault",
+		"L1FeeVault",
+	}
+}
+
+// ValidateMandatory returns an error if the config disables a mandatory predeploy.
+func ValidateMandatory(config DeployConfig) error {
+	active := ActivePredeploys(config)
+	for _, name := range MandatoryPredeploys() {
+		if _, ok := active[name]; !ok {
+			return fmt.Errorf("mandatory predeploy %s is disabled", name)
+		}
+	}
+	return nil
+}
+
+// StartupRequiredPredeploys returns the addresses, sorted, of the mandatory
+// predeploys active for the config. A node should check that each of them
+// has code, e.g. with eth_getCode, and refuse to start otherwise.
+func StartupRequiredPredeploys(config DeployConfig) []common.Address {
+	active := ActivePredeploys(config)
+	var addrs []common.Address
+	for _, name := range MandatoryPredeploys() {
+		if predeploy, ok := active[name]; ok {
+			addrs = append(addrs, predeploy.Address)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs
+}
+
+// ForkDependentPredeploys returns the names of the predeploys whose
+// bytecode depends on the forks active at genesis, so artifact loaders must
+// pick the version matching the genesis fork.
+func ForkDependentPredeploys() []string {
+	return []string{
+		"GasPriceOracle",
+		"L1Block",
+	}
+}
+
+// ImplicitlyCalledPredeploys returns the predeploys that are invoked or
+// read by the protocol itself rather than by users, much like precompiles.
+// The L1 fee calculation relies on both of them.
+func ImplicitlyCalledPredeploys() []common.Address {
+	return []common.Address{
+		GasPriceOracleAddr,
+		L1BlockAddr,
+	}
+}
+
+// ReadOnlyCallablePredeploys returns the names of the predeploys whose view
+// functions only depend on state set by the protocol, so their results can
+// be cached per block by RPC layers.
+func ReadOnlyCallablePredeploys() []string {
+	return []string{
+		"GasPriceOracle",
+		"L1Block",
+	}
+}
+
+// L1FeePathPredeploys returns the predeploys along the path of the L1 data
+// fee, in order: the L1Block holds the L1 attributes, the GasPriceOracle
+// computes the fee from them, and the L1FeeVault collects it.
+func L1FeePathPredeploys() []common.Address {
+	return []common.Address{
+		L1BlockAddr,
+		GasPriceOracleAddr,
+		L1FeeVaultAddr,
+	}
+}
+
+// ConsensusCriticalPredeploys returns the names of the predeploys whose
+// behavior is part of block validity. Changing any of them requires a fork.
+func ConsensusCriticalPredeploys() []string {
+	return []string{
+		"L1Block",
+		"GasPriceOracle",
+		"L2ToL1MessagePasser",
+	}
+}
+
+// AllowedDepositTargets returns the predeploys that may be the target of
+// system deposits, i.e. deposits derived by the rollup node rather than
+// initiated by users on L1. Only the L1 attributes deposit to L1Block
+// qualifies today; user deposits to the bridges and messengers do not.
+func AllowedDepositTargets() []common.Address {
+	return []common.Address{
+		L1BlockAddr,
+	}
+}
+
+// ownerSlots are the slots of the _owner of the Ownable predeploys.
+var ownerSlots = map[string]common.Hash{
+	"ProxyAdmin":      common.BigToHash(common.Big0),
+	"GovernanceToken": common.BigToHash(big.NewInt(10)),
+}
+
+// AdminSlot returns the storage slot holding the admin of the predeploy
+// that must be seeded at genesis: the _owner of Ownable predeploys, or
+// else the EIP-1967 admin slot of proxied predeploys. It returns false for
+// unknown predeploys and for predeploys without an admin.
+func AdminSlot(name string) (common.Hash, bool) {
+	if slot, ok := ownerSlots[name]; ok {
+		return slot, true
+	}
+	predeploy, ok := lookup(name)
+	if !ok || predeploy.ProxyDisabled {
+		return common.Hash{}, false
+	}
+	return adminSlot, true
+}
+
+// PredeploysWithAdminSlot returns the sorted names of the registered
+// predeploys that have an admin slot.
+func PredeploysWithAdminSlot() []string {
+	var names []string
+	for name := range registered() {
+		if _, ok := AdminSlot(name); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,147 @@
+package predeploys
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemTxTargets(t *testing.T) {
+	targets := SystemTxTargets()
+	require.Equal(t, []common.Address{L1BlockAddr}, targets)
+	require.NotContains(t, targets, SequencerFeeVaultAddr)
+}
+
+func TestMandatoryPredeploys(t *testing.T) {
+	mandatory := MandatoryPredeploys()
+	require.Contains(t, mandatory, "L2CrossDomainMessenger")
+	require.NotContains(t, mandatory, "GovernanceToken")
+	require.NotContains(t, mandatory, "EAS")
+	for _, name := range mandatory {
+		require.Contains(t, Predeploys, name)
+	}
+}
+
+func TestValidateMandatory(t *testing.T) {
+	restoreRegistry(t)
+	require.NoError(t, ValidateMandatory(&testDeployConfig{}))
+
+	require.NoError(t, SetEnabledOverride("EAS", false))
+	require.NoError(t, ValidateMandatory(&testDeployConfig{}))
+
+	require.NoError(t, SetEnabledOverride("L2CrossDomainMessenger", false))
+	require.ErrorContains(t, ValidateMandatory(&testDeployConfig{}), "L2CrossDomainMessenger")
+}
+
+func TestStartupRequiredPredeploys(t *testing.T) {
+	config := &faucetDeployConfig{enabled: true}
+	require.Contains(t, ActivePredeploys(config), "L2Faucet")
+	addrs := StartupRequiredPredeploys(config)
+	require.Contains(t, addrs, L1BlockAddr)
+	require.Contains(t, addrs, L2CrossDomainMessengerAddr)
+	require.NotContains(t, addrs, L2FaucetAddr)
+	require.Len(t, addrs, len(MandatoryPredeploys()))
+}
+
+func TestForkDependentPredeploys(t *testing.T) {
+	names := ForkDependentPredeploys()
+	require.Contains(t, names, "GasPriceOracle")
+	require.NotContains(t, names, "WETH9")
+	for _, name := range names {
+		require.Contains(t, Predeploys, name)
+	}
+}
+
+func TestImplicitlyCalledPredeploys(t *testing.T) {
+	addrs := ImplicitlyCalledPredeploys()
+	require.Contains(t, addrs, GasPriceOracleAddr)
+	require.NotContains(t, addrs, L2StandardBridgeAddr)
+}
+
+func TestReadOnlyCallablePredeploys(t *testing.T) {
+	names := ReadOnlyCallablePredeploys()
+	require.Contains(t, names, "GasPriceOracle")
+	require.NotContains(t, names, "L2StandardBridge")
+	require.NotContains(t, names, "OasysL2ERC721Bridge")
+	for _, name := range names {
+		require.Contains(t, Predeploys, name)
+	}
+}
+
+func TestL1FeePathPredeploys(t *testing.T) {
+	path := L1FeePathPredeploys()
+	oracle := slices.Index(path, GasPriceOracleAddr)
+	vault := slices.Index(path, L1FeeVaultAddr)
+	require.NotEqual(t, -1, oracle)
+	require.NotEqual(t, -1, vault)
+	require.Less(t, oracle, vault)
+	require.NotContains(t, path, SequencerFeeVaultAddr)
+}
+
+func TestConsensusCriticalPredeploys(t *testing.T) {
+	names := ConsensusCriticalPredeploys()
+	require.ElementsMatch(t, []string{"L1Block", "GasPriceOracle", "L2ToL1MessagePasser"}, names)
+	require.NotContains(t, names, "WETH9")
+}
+
+func TestAllowedDepositTargets(t *testing.T) {
+	targets := AllowedDepositTargets()
+	require.Contains(t, targets, L1BlockAddr)
+	require.NotContains(t, targets, L2StandardBridgeAddr)
+	require.NotContains(t, targets, OasysL2ERC721BridgeAddr)
+	require.NotContains(t, targets, L2CrossDomainMessengerAddr)
+}
+
+func TestWrappedNative(t *testing.T) {
+	require.Equal(t, common.HexToAddress(WETH9), WrappedNative())
+	require.True(t, PredeploysByAddress[WrappedNative()].ProxyDisabled)
+}
+
+type deployerWhitelistDeployConfig struct {
+	testDeployConfig
+	enabled bool
+}
+
+func (c *deployerWhitelistDeployConfig) DeployerWhitelistEnabled() bool {
+	return c.enabled
+}
+
+func TestDeploymentGate(t *testing.T) {
+	addr, enforced := DeploymentGate(&deployerWhitelistDeployConfig{enabled: true})
+	require.Equal(t, DeployerWhitelistAddr, addr)
+	require.True(t, enforced)
+
+	addr, enforced = DeploymentGate(&testDeployConfig{})
+	require.Equal(t, DeployerWhitelistAddr, addr)
+	require.False(t, enforced)
+	_, enforced = DeploymentGate(&deployerWhitelistDeployConfig{})
+	require.False(t, enforced)
+}
+
+func TestAdminSlot(t *testing.T) {
+	names := PredeploysWithAdminSlot()
+	require.Contains(t, names, "ProxyAdmin")
+	require.Contains(t, names, "SequencerFeeVault")
+	require.NotContains(t, names, "WETH9")
+
+	slot, ok := AdminSlot("ProxyAdmin")
+	require.True(t, ok)
+	require.Equal(t, common.Hash{}, slot)
+
+	slot, ok = AdminSlot("SequencerFeeVault")
+	require.True(t, ok)
+	require.Equal(t, common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103"), slot)
+
+	slot, ok = AdminSlot("GovernanceToken")
+	require.True(t, ok)
+	require.Equal(t, common.BigToHash(big.NewInt(10)), slot)
+
+	_, ok = AdminSlot("WETH9")
+	require.False(t, ok)
+	_, ok = AdminSlot("Unknown")
+	require.False(t, ok)
+}
@@ -0,0 +1,137 @@
+package predeploys
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// mappingSlot returns the storage slot of the value for key in a Solidity
+// mapping stored at slot.
+func mappingSlot(key common.Hash, slot uint64) common.Hash {
+	return crypto.Keccak256Hash(key[:], common.BigToHash(new(big.Int).SetUint64(slot)).Bytes())
+}
+
+// arraySlot returns the storage slot of the element at index in a Solidity
+// dynamic array stored at slot.
+func arraySlot(slot uint64, index uint64) common.Hash {
+	base := crypto.Keccak256Hash(common.BigToHash(new(big.Int).SetUint64(slot)).Bytes()).Big()
+	return common.BigToHash(base.Add(base, new(big.Int).SetUint64(index)))
+}
+
+// stringStorage returns the storage of a Solidity string stored at slot.
+// Strings of up to 31 bytes are stored in the slot itself along with twice
+// their length; longer ones store twice their length plus one in the slot
+// and their data in the consecutive slots starting at keccak256(slot).
+func stringStorage(slot uint64, value string) map[common.Hash]common.Hash {
+	key := common.BigToHash(new(big.Int).SetUint64(slot))
+	length := len(value)
+	if length < common.HashLength {
+		var word common.Hash
+		copy(word[:], value)
+		word[common.HashLength-1] = byte(length * 2)
+		return map[common.Hash]common.Hash{key: word}
+	}
+	storage := map[common.Hash]common.Hash{
+		key: common.BigToHash(big.NewInt(int64(length*2 + 1))),
+	}
+	base := crypto.Keccak256Hash(key[:]).Big()
+	for i := 0; i*common.HashLength < length; i++ {
+		var word common.Hash
+		copy(word[:], value[i*common.HashLength:])
+		storage[common.BigToHash(new(big.Int).Add(base, big.NewInt(int64(i))))] = word
+	}
+	return storage
+}
+
+// DetectInitStorageConflicts checks that the InitStorage of every active
+// predeploy only seeds storage it owns: the predeploy address must not be
+// registered to another predeploy, and proxied predeploys must not seed the
+// EIP-1967 slots that are owned by their proxy.
+func DetectInitStorageConflicts(config DeployConfig) error {
+	active := ActivePredeploys(config)
+	names := make([]string, 0, len(active))
+	for name := range active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		predeploy := active[name]
+		if predeploy.InitStorage == nil {
+			continue
+		}
+		storage := predeploy.InitStorage(config)
+		if len(storage) == 0 {
+			continue
+		}
+		if owner, _ := lookupAddress(predeploy.Address); owner != predeploy {
+			return fmt.Errorf("predeploy %s seeds storage at %s, which belongs to another predeploy", name, predeploy.Address)
+		}
+		if predeploy.ProxyDisabled {
+			continue
+		}
+		for _, slot := range []common.Hash{implementationSlot, adminSlot} {
+			if _, ok := storage[slot]; ok {
+				return fmt.Errorf("predeploy %s seeds proxy slot %s", name, slot)
+			}
+		}
+	}
+	return nil
+}
+
+// storageRoot returns the root of the storage trie holding the slots.
+// Zero values are not stored in the trie.
+func storageRoot(storage map[common.Hash]common.Hash) common.Hash {
+	keys := make([][]byte, 0, len(storage))
+	values := make(map[string][]byte, len(storage))
+	for key, value := range storage {
+		if value == (common.Hash{}) {
+			continue
+		}
+		hashed := crypto.Keccak256(key[:])
+		// Encoding a byte slice cannot fail.
+		enc, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value[:]))
+		if err != nil {
+			panic(err)
+		}
+		keys = append(keys, hashed)
+		values[string(hashed)] = enc
+	}
+	if len(keys) == 0 {
+		return types.EmptyRootHash
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	st := trie.NewStackTrie(nil)
+	for _, key := range keys {
+		if err := st.Update(key, values[string(key)]); err != nil {
+			panic(err)
+		}
+	}
+	return st.Hash()
+}
+
+// StorageRoots returns the storage trie root of the genesis account of each
+// active predeploy, keyed by address: the EIP-1967 proxy slots, if proxied,
+// and its InitStorage. Unproxied predeploys without init storage have the
+// empty root.
+func StorageRoots(config DeployConfig) (map[common.Address]common.Hash, error) {
+	roots := make(map[common.Address]common.Hash)
+	for name, predeploy := range ActivePredeploys(config) {
+		storage, err := expectedStorage(predeploy, config)
+		if err != nil {
+			return nil, fmt.Errorf("predeploy %s: %w", name, err)
+		}
+		roots[predeploy.Address] = storageRoot(storage)
+	}
+	return roots, nil
+}
@@ -0,0 +1,69 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectInitStorageConflicts(t *testing.T) {
+	require.NoError(t, DetectInitStorageConflicts(&testDeployConfig{}))
+	require.NoError(t, DetectInitStorageConflicts(&forkDeployConfig{}))
+}
+
+func TestDetectInitStorageConflictsOtherAddress(t *testing.T) {
+	restoreRegistry(t)
+	// Bypass Register, which rejects duplicate addresses, to simulate a
+	// misconfigured predeploy seeding the storage of L1Block.
+	Predeploys["TestConflict"] = &Predeploy{
+		Address: L1BlockAddr,
+		InitStorage: func(config DeployConfig) map[common.Hash]common.Hash {
+			return map[common.Hash]common.Hash{{}: common.BigToHash(common.Big1)}
+		},
+	}
+	require.ErrorContains(t, DetectInitStorageConflicts(&testDeployConfig{}), "TestConflict seeds storage at")
+}
+
+func TestDetectInitStorageConflictsProxySlot(t *testing.T) {
+	restoreRegistry(t)
+	addr := common.HexToAddress("0x42000000000000000000000000000000000000f0")
+	require.NoError(t, Register("TestConflict", &Predeploy{
+		Address: addr,
+		InitStorage: func(config DeployConfig) map[common.Hash]common.Hash {
+			return map[common.Hash]common.Hash{adminSlot: common.BigToHash(common.Big1)}
+		},
+	}))
+	require.ErrorContains(t, DetectInitStorageConflicts(&testDeployConfig{}), "TestConflict seeds proxy slot")
+}
+
+func TestStorageRoots(t *testing.T) {
+	config := &gasTokenDeployConfig{
+		testDeployConfig: testDeployConfig{canyonTime: u64(0)},
+		enabled:          true,
+		token:            common.HexToAddress("0x1234"),
+	}
+	roots, err := StorageRoots(config)
+	require.NoError(t, err)
+	require.Len(t, roots, len(ActivePredeploys(config)))
+	require.Equal(t, types.EmptyRootHash, roots[Create2DeployerAddr])
+	require.NotEqual(t, types.EmptyRootHash, roots[L1BlockAddr])
+	require.NotEqual(t, roots[L1BlockAddr], roots[L2GasTokenAddr])
+
+	// The root matches the one computed by the state database, including
+	// the proxy slots.
+	db, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	impl, err := ImplementationAddress(L2GasTokenAddr)
+	require.NoError(t, err)
+	db.SetState(L2GasTokenAddr, implementationSlot, common.BytesToHash(impl.Bytes()))
+	db.SetState(L2GasTokenAddr, adminSlot, common.BytesToHash(ProxyAdminAddr.Bytes()))
+	for key, value := range Predeploys["L2GasToken"].InitStorage(config) {
+		db.SetState(L2GasTokenAddr, key, value)
+	}
+	db.IntermediateRoot(false)
+	require.Equal(t, db.GetStorageRoot(L2GasTokenAddr), roots[L2GasTokenAddr])
+}
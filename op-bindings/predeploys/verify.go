@@ -0,0 +1,122 @@
+package predeploys
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Create2DeployerCodeHash is the hash of the canonical code of the universal
+// Create2Deployer, which lives at the same address on every chain.
+var Create2DeployerCodeHash = common.HexToHash("0xb0550b5b431e30d38000efb7107aaa0ade03d48a7198a140edda9d27134468b2")
+
+// Multicall3CodeHash is the hash of the canonical code of Multicall3, which
+// lives at the same address on every chain.
+var Multicall3CodeHash = common.HexToHash("0xd5c15df687b16f2ff992fc8d767b4216323184a2bbc6ee2f9c398c318e770891")
+
+// universalCodeHashes are the code hashes of the proxy-disabled predeploys
+// whose canonical code is shared with other chains, keyed by name.
+var universalCodeHashes = map[string]common.Hash{
+	"Create2Deployer": Create2DeployerCodeHash,
+	"Multicall3":      Multicall3CodeHash,
+}
+
+// ResolveDeployedBytecode returns the runtime code of a predeploy. The code
+// of predeploys deployed at the same address on every chain is loaded by
+// code hash from the bytecodes bundled with the superchain registry, or from
+// the bindings if the registry does not bundle it, and must match the
+// canonical code hash. The code of the others is loaded from the bindings.
+func ResolveDeployedBytecode(name string) ([]byte, error) {
+	codeHash, ok := universalCodeHashes[name]
+	if !ok {
+		code, err := bindings.GetDeployedBytecode(name)
+		if err != nil {
+			return nil, fmt.Errorf("predeploy %s has no bytecode: %w", name, err)
+		}
+		return code, nil
+	}
+	code, err := superchain.LoadContractBytecode(superchain.Hash(codeHash))
+	if err != nil {
+		code, err = bindings.GetDeployedBytecode(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s bytecode: %w", name, err)
+		}
+	}
+	if hash := crypto.Keccak256Hash(code); hash != codeHash {
+		return nil, fmt.Errorf("%s bytecode hash %s does not match the canonical %s", name, hash, codeHash)
+	}
+	return code, nil
+}
+
+// ValidateBytecode checks that every predeploy active for the config has
+// deployed bytecode. Predeploys may be registered ahead of their contracts,
+// so a config enabling one of those must fail here rather than produce a
+// genesis without it.
+func ValidateBytecode(config DeployConfig) error {
+	active := ActivePredeploys(config)
+	names := make([]string, 0, len(active))
+	for name := range active {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if _, err := ResolveDeployedBytecode(name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CodeHashMismatch describes a predeploy whose live code hash does not
+// match the expected one.
+type CodeHashMismatch struct {
+	Name     string
+	Address  common.Address
+	Expected common.Hash
+	Actual   common.Hash
+}
+
+// VerifyCodeHashes compares the code hash of each active predeploy, as
+// returned by getHash, against the expected hash for its name. Predeploys
+// without an expected hash are skipped. Mismatches are sorted by name.
+func VerifyCodeHashes(config DeployConfig, getHash func(common.Address) common.Hash, expected map[string]common.Hash) []CodeHashMismatch {
+	var mismatches []CodeHashMismatch
+	for name, predeploy := range ActivePredeploys(config) {
+		want, ok := expected[name]
+		if !ok {
+			continue
+		}
+		if got := getHash(predeploy.Address); got != want {
+			mismatches = append(mismatches, CodeHashMismatch{
+				Name:     name,
+				Address:  predeploy.Address,
+				Expected: want,
+				Actual:   got,
+			})
+		}
+	}
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].Name < mismatches[j].Name
+	})
+	return mismatches
+}
+
+// VerifyUniversalAddresses checks that the universal Create2Deployer is
+// deployed with its canonical code, as returned by getCode.
+func VerifyUniversalAddresses(getCode func(common.Address) []byte) error {
+	code := getCode(Create2DeployerAddr)
+	if len(code) == 0 {
+		return fmt.Errorf("no code at Create2Deployer address %s", Create2DeployerAddr)
+	}
+	if hash := crypto.Keccak256Hash(code); hash != Create2DeployerCodeHash {
+		return fmt.Errorf("unexpected Create2Deployer code hash %s, expected %s", hash, Create2DeployerCodeHash)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package predeploys
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyCodeHashes(t *testing.T) {
+	hashes := map[common.Address]common.Hash{
+		L2StandardBridgeAddr: crypto.Keccak256Hash([]byte("bridge")),
+		L1BlockAddr:          crypto.Keccak256Hash([]byte("l1block")),
+	}
+	getHash := func(addr common.Address) common.Hash {
+		return hashes[addr]
+	}
+	expected := map[string]common.Hash{
+		"L2StandardBridge": crypto.Keccak256Hash([]byte("bridge")),
+		"L1Block":          crypto.Keccak256Hash([]byte("other")),
+		// Disabled predeploys are not checked.
+		"GovernanceToken": crypto.Keccak256Hash([]byte("token")),
+	}
+
+	mismatches := VerifyCodeHashes(&testDeployConfig{}, getHash, expected)
+	require.Equal(t, []CodeHashMismatch{{
+		Name:     "L1Block",
+		Address:  L1BlockAddr,
+		Expected: crypto.Keccak256Hash([]byte("other")),
+		Actual:   crypto.Keccak256Hash([]byte("l1block")),
+	}}, mismatches)
+}
+
+func TestVerifyUniversalAddresses(t *testing.T) {
+	code, err := superchain.LoadContractBytecode(superchain.Hash(Create2DeployerCodeHash))
+	require.NoError(t, err)
+	require.NoError(t, VerifyUniversalAddresses(func(addr common.Address) []byte {
+		require.Equal(t, Create2DeployerAddr, addr)
+		return code
+	}))
+
+	require.ErrorContains(t, VerifyUniversalAddresses(func(common.Address) []byte {
+		return nil
+	}), "no code")
+	require.ErrorContains(t, VerifyUniversalAddresses(func(common.Address) []byte {
+		return []byte{0x00}
+	}), "unexpected Create2Deployer code hash")
+}
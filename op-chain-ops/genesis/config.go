@@ -173,6 +173,9 @@ type DeployConfig struct {
 	// GovernanceTokenOwner represents the owner of the GovernanceToken. Has the ability
 	// to mint and burn tokens.
 	GovernanceTokenOwner common.Address `json:"governanceTokenOwner"`
+	// EnableMulticall3 configures whether or not to include the Multicall3 predeploy
+	// at its canonical address.
+	EnableMulticall3 bool `json:"enableMulticall3,omitempty"`
 	// DeploymentWaitConfirmations is the number of confirmations to wait during
 	// deployment. This is DEPRECATED and should be removed in a future PR.
 	DeploymentWaitConfirmations int `json:"deploymentWaitConfirmations"`
@@ -444,6 +447,66 @@ func (d *DeployConfig) GovernanceEnabled() bool {
 	return d.EnableGovernance
 }
 
+var _ predeploys.Multicall3Config = (*DeployConfig)(nil)
+
+// Multicall3Enabled returns whether the Multicall3 predeploy is included.
+func (d *DeployConfig) Multicall3Enabled() bool {
+	return d.EnableMulticall3
+}
+
+var _ predeploys.FeeVaultConfig = (*DeployConfig)(nil)
+
+// FeeVaultRecipient returns the recipient of the fee vault with the given predeploy name.
+func (d *DeployConfig) FeeVaultRecipient(vault string) common.Address {
+	switch vault {
+	case "SequencerFeeVault":
+		return d.SequencerFeeVaultRecipient
+	case "BaseFeeVault":
+		return d.BaseFeeVaultRecipient
+	case "L1FeeVault":
+		return d.L1FeeVaultRecipient
+	default:
+		return common.Address{}
+	}
+}
+
+// FeeVaultWithdrawalNetwork returns the withdrawal network of the fee vault with the given predeploy name.
+func (d *DeployConfig) FeeVaultWithdrawalNetwork(vault string) predeploys.WithdrawalNetwork {
+	var network WithdrawalNetwork
+	switch vault {
+	case "SequencerFeeVault":
+		network = d.SequencerFeeVaultWithdrawalNetwork
+	case "BaseFeeVault":
+		network = d.BaseFeeVaultWithdrawalNetwork
+	case "L1FeeVault":
+		network = d.L1FeeVaultWithdrawalNetwork
+	}
+	return predeploys.WithdrawalNetwork(network.ToUint8())
+}
+
+var _ predeploys.FeeVaultMinimumConfig = (*DeployConfig)(nil)
+
+// FeeVaultMinimum returns the minimum withdrawal amount of the fee vault with the given predeploy name.
+func (d *DeployConfig) FeeVaultMinimum(vault string) *big.Int {
+	switch vault {
+	case "SequencerFeeVault":
+		return d.SequencerFeeVaultMinimumWithdrawalAmount.ToInt()
+	case "BaseFeeVault":
+		return d.BaseFeeVaultMinimumWithdrawalAmount.ToInt()
+	case "L1FeeVault":
+		return d.L1FeeVaultMinimumWithdrawalAmount.ToInt()
+	default:
+		return nil
+	}
+}
+
+var _ predeploys.SequencerFeeVaultMinimumConfig = (*DeployConfig)(nil)
+
+// SequencerFeeVaultMinimum returns the minimum withdrawal amount of the SequencerFeeVault.
+func (d *DeployConfig) SequencerFeeVaultMinimum() *big.Int {
+	return d.SequencerFeeVaultMinimumWithdrawalAmount.ToInt()
+}
+
 func (d *DeployConfig) RegolithTime(genesisTime uint64) *uint64 {
 	if d.L2GenesisRegolithTimeOffset == nil {
 		return nil
@@ -466,6 +529,11 @@ func (d *DeployConfig) CanyonTime(genesisTime uint64) *uint64 {
 	return &v
 }
 
+// ForkSchedule returns the activation offsets of the forks relative to L2 genesis.
+func (d *DeployConfig) ForkSchedule() predeploys.ForkSchedule {
+	return predeploys.ForkSchedule{CanyonTime: d.CanyonTime(0)}
+}
+
 func (d *DeployConfig) SpanBatchTime(genesisTime uint64) *uint64 {
 	if d.L2GenesisSpanBatchTimeOffset == nil {
 		return nil
@@ -699,18 +767,20 @@ func NewL2ImmutableConfig(config *DeployConfig, block *types.Block) (immutables.
 	}
 	immutable["SequencerFeeVault"] = immutables.ImmutableValues{
 		"recipient":               config.SequencerFeeVaultRecipient,
-		"minimumWithdrawalAmount": config.SequencerFeeVaultMinimumWithdrawalAmount,
+		"minimumWithdrawalAmount": (*hexutil.Big)(predeploys.SequencerFeeVaultMinWithdrawal(config)),
 		"withdrawalNetwork":       config.SequencerFeeVaultWithdrawalNetwork.ToUint8(),
 	}
+	l1FeeVault, _ := predeploys.L1FeeVaultImmutables(config)
 	immutable["L1FeeVault"] = immutables.ImmutableValues{
-		"recipient":               config.L1FeeVaultRecipient,
-		"minimumWithdrawalAmount": config.L1FeeVaultMinimumWithdrawalAmount,
-		"withdrawalNetwork":       config.L1FeeVaultWithdrawalNetwork.ToUint8(),
+		"recipient":               l1FeeVault.Recipient,
+		"minimumWithdrawalAmount": (*hexutil.Big)(l1FeeVault.MinWithdrawalAmount),
+		"withdrawalNetwork":       uint8(l1FeeVault.WithdrawalNetwork),
 	}
+	baseFeeVault, _ := predeploys.BaseFeeVaultImmutables(config)
 	immutable["BaseFeeVault"] = immutables.ImmutableValues{
-		"recipient":               config.BaseFeeVaultRecipient,
-		"minimumWithdrawalAmount": config.BaseFeeVaultMinimumWithdrawalAmount,
-		"withdrawalNetwork":       config.BaseFeeVaultWithdrawalNetwork.ToUint8(),
+		"recipient":               baseFeeVault.Recipient,
+		"minimumWithdrawalAmount": (*hexutil.Big)(baseFeeVault.MinWithdrawalAmount),
+		"withdrawalNetwork":       uint8(baseFeeVault.WithdrawalNetwork),
 	}
 	immutable["OptimismMintableERC20Factory"] = immutables.ImmutableValues{
 		"bridge": predeploys.L2StandardBridgeAddr,
@@ -12,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 )
 
 func TestConfigDataMarshalUnmarshal(t *testing.T) {
@@ -109,3 +111,18 @@ func TestL1Deployments(t *testing.T) {
 	// One that doesn't exist returns empty string
 	require.Equal(t, "", deployments.GetName(common.Address{19: 0xff}))
 }
+
+func BenchmarkActivePredeploys(b *testing.B) {
+	config, err := NewDeployConfig("testdata/test-deploy-config-full.json")
+	require.NoError(b, err)
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			predeploys.ActivePredeploys(config)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			predeploys.CachedActive(config)
+		}
+	})
+}
@@ -15,22 +15,16 @@ import (
 )
 
 var (
-	// codeNamespace represents the namespace of implementations of predeploys
-	codeNamespace = common.HexToAddress("0xc0D3C0d3C0d3C0D3c0d3C0d3c0D3C0d3c0d30000")
 	// l2PredeployNamespace represents the namespace of L2 predeploys
 	l2PredeployNamespace = common.HexToAddress("0x4200000000000000000000000000000000000000")
 	// BigL2PredeployNamespace represents the predeploy namespace as a big.Int
 	BigL2PredeployNamespace = new(big.Int).SetBytes(l2PredeployNamespace.Bytes())
-	// bigCodeNamespace represents the predeploy namespace as a big.Int
-	bigCodeNamespace = new(big.Int).SetBytes(codeNamespace.Bytes())
 	// ImplementationSlot represents the EIP 1967 implementation storage slot
 	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
 	// AdminSlot represents the EIP 1967 admin storage slot
 	AdminSlot = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
 
 	// Namespace for L2 predeploy contracts made by Oasys.
-	oasysCodeNamespace           = common.HexToAddress("0xC0F6C0f6C0F6C0f6C0f6c0F6C0f6C0F6c0f60000")
-	bigOasysCodeNamespace        = new(big.Int).SetBytes(oasysCodeNamespace.Bytes())
 	oasysL2PredeployNamespace    = common.HexToAddress("0x6200000000000000000000000000000000000000")
 	OasysBigL2PredeployNamespace = new(big.Int).SetBytes(oasysL2PredeployNamespace.Bytes())
 )
@@ -75,13 +69,7 @@ func AddressToCodeNamespace(addr common.Address) (common.Address, error) {
 	if addr == predeploys.OPStackL2ERC721BridgeAddr {
 		return common.Address{}, fmt.Errorf("do not use the OPStack's L2ERC721Bridge")
 	}
-	namespace := getCodeNamespace(addr)
-	if namespace == nil {
-		return common.Address{}, fmt.Errorf("cannot handle non predeploy: %s", addr)
-	}
-	bigAddress := new(big.Int).SetBytes(addr[18:])
-	num := new(big.Int).Or(namespace, bigAddress)
-	return common.BigToAddress(num), nil
+	return predeploys.ImplementationAddress(addr)
 }
 
 func IsL2DevPredeploy(addr common.Address) bool {
@@ -118,13 +106,3 @@ func newHexBig(in uint64) *hexutil.Big {
 	hb := hexutil.Big(*b)
 	return &hb
 }
-
-func getCodeNamespace(addr common.Address) *big.Int {
-	if bytes.Equal(addr[0:2], []byte{0x42, 0x00}) {
-		return bigCodeNamespace
-	}
-	if bytes.Equal(addr[0:2], []byte{0x62, 0x00}) {
-		return bigOasysCodeNamespace
-	}
-	return nil
-}
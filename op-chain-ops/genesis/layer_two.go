@@ -15,6 +15,10 @@ import (
 
 // BuildL2Genesis will build the L2 genesis block.
 func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block) (*core.Genesis, error) {
+	if err := predeploys.ValidateBytecode(config); err != nil {
+		return nil, fmt.Errorf("invalid predeploys: %w", err)
+	}
+
 	genspec, err := NewL2Genesis(config, l1StartBlock)
 	if err != nil {
 		return nil, err
@@ -53,8 +57,9 @@ func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block) (*core.Gene
 	if err != nil {
 		return nil, err
 	}
+	active := predeploys.ActivePredeploys(config)
 	for name, predeploy := range predeploys.Predeploys {
-		if predeploy.Enabled != nil && !predeploy.Enabled(config) {
+		if _, ok := active[name]; !ok {
 			log.Warn("Skipping disabled predeploy.", "name", name, "address", predeploy.Address)
 			continue
 		}
@@ -73,6 +78,16 @@ func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block) (*core.Gene
 		if err := setupPredeploy(db, deployResults, storage, name, predeploy.Address, codeAddr); err != nil {
 			return nil, err
 		}
+		if predeploy.InitStorage != nil {
+			for key, value := range predeploy.InitStorage(config) {
+				db.SetState(predeploy.Address, key, value)
+			}
+		}
+		if predeploy.GenesisBalance != nil {
+			if balance := predeploy.GenesisBalance(config); balance != nil && balance.Sign() > 0 {
+				db.AddBalance(predeploy.Address, balance)
+			}
+		}
 		code := db.GetCode(codeAddr)
 		if len(code) == 0 {
 			return nil, fmt.Errorf("code not set for %s", name)
@@ -13,6 +13,9 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
@@ -48,8 +51,14 @@ func testBuildL2Genesis(t *testing.T, config *genesis.DeployConfig) *core.Genesi
 	proxyBytecode, err := bindings.GetDeployedBytecode("Proxy")
 	require.NoError(t, err)
 
+	active := predeploys.ActivePredeploys(config)
 	for name, predeploy := range predeploys.Predeploys {
 		addr := predeploy.Address
+		// Disabled predeploys outside of the proxied namespace are not allocated.
+		if _, ok := active[name]; !ok && predeploy.ProxyDisabled && !genesis.IsL2DevPredeploy(addr) {
+			require.NotContains(t, gen.Alloc, addr, name)
+			continue
+		}
 
 		account, ok := gen.Alloc[addr]
 		require.Equal(t, true, ok, name)
@@ -112,3 +121,75 @@ func TestBuildL2MainnetNoGovernanceGenesis(t *testing.T) {
 			20 // Implementations
 	require.Equal(t, expect, len(gen.Alloc))
 }
+
+func TestBuildL2GenesisFeeVaultStorage(t *testing.T) {
+	config, err := genesis.NewDeployConfig("./testdata/test-deploy-config-devnet-l1.json")
+	require.Nil(t, err)
+	gen := testBuildL2Genesis(t, config)
+
+	storage := gen.Alloc[predeploys.L1FeeVaultAddr].Storage
+	require.Equal(t, config.L1FeeVaultRecipient, common.BytesToAddress(storage[predeploys.L1FeeVaultRecipientSlot].Bytes()))
+	require.Equal(t, uint64(predeploys.WithdrawalNetworkL2), storage[predeploys.L1FeeVaultWithdrawalNetworkSlot].Big().Uint64())
+}
+
+func TestBuildL2GenesisBaseFeeVaultStorage(t *testing.T) {
+	config, err := genesis.NewDeployConfig("./testdata/test-deploy-config-devnet-l1.json")
+	require.Nil(t, err)
+	gen := testBuildL2Genesis(t, config)
+
+	storage := gen.Alloc[predeploys.BaseFeeVaultAddr].Storage
+	require.Equal(t, config.BaseFeeVaultRecipient, common.BytesToAddress(storage[predeploys.L1FeeVaultRecipientSlot].Bytes()))
+	require.Equal(t, uint64(predeploys.WithdrawalNetworkL2), storage[predeploys.L1FeeVaultWithdrawalNetworkSlot].Big().Uint64())
+	require.Equal(t, config.BaseFeeVaultMinimumWithdrawalAmount.ToInt(), storage[predeploys.BaseFeeVaultMinWithdrawalSlot].Big())
+}
+
+func TestBuildL2GenesisStorageRoots(t *testing.T) {
+	config, err := genesis.NewDeployConfig("./testdata/test-deploy-config-devnet-l1.json")
+	require.Nil(t, err)
+	gen := testBuildL2Genesis(t, config)
+
+	// Predeploys whose genesis storage is also set from the storage config
+	// are not covered by StorageRoots.
+	block := types.NewBlockWithHeader(&types.Header{Number: common.Big0, BaseFee: common.Big1})
+	storageConfig, err := genesis.NewL2StorageConfig(config, block)
+	require.NoError(t, err)
+
+	roots, err := predeploys.StorageRoots(config)
+	require.NoError(t, err)
+	for name, predeploy := range predeploys.ActivePredeploys(config) {
+		if _, ok := storageConfig[name]; ok {
+			continue
+		}
+		db, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		require.NoError(t, err)
+		db.CreateAccount(predeploy.Address)
+		for key, value := range gen.Alloc[predeploy.Address].Storage {
+			db.SetState(predeploy.Address, key, value)
+		}
+		db.IntermediateRoot(false)
+		require.Equal(t, db.GetStorageRoot(predeploy.Address), roots[predeploy.Address], name)
+	}
+}
+
+func TestBuildL2GenesisMulticall3(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		config, err := genesis.NewDeployConfig("./testdata/test-deploy-config-devnet-l1.json")
+		require.Nil(t, err)
+		config.EnableMulticall3 = true
+		gen := testBuildL2Genesis(t, config)
+
+		account, ok := gen.Alloc[predeploys.Multicall3Addr]
+		require.True(t, ok)
+		require.Equal(t, predeploys.Multicall3CodeHash, crypto.Keccak256Hash(account.Code))
+		require.Empty(t, account.Storage)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		config, err := genesis.NewDeployConfig("./testdata/test-deploy-config-devnet-l1.json")
+		require.Nil(t, err)
+		require.False(t, config.EnableMulticall3)
+		gen := testBuildL2Genesis(t, config)
+
+		require.NotContains(t, gen.Alloc, predeploys.Multicall3Addr)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/immutables"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/state"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -66,16 +67,16 @@ func SetPrecompileBalances(db vm.StateDB) {
 
 func setupPredeploy(db vm.StateDB, deployResults immutables.DeploymentResults, storage state.StorageConfig, name string, proxyAddr common.Address, implAddr common.Address) error {
 	// Use the generated bytecode when there are immutables
-	// otherwise use the artifact deployed bytecode
+	// otherwise use the artifact or canonical deployed bytecode
 	if bytecode, ok := deployResults[name]; ok {
 		log.Info("Setting deployed bytecode with immutables", "name", name, "address", implAddr)
 		db.SetCode(implAddr, bytecode)
 	} else {
-		depBytecode, err := bindings.GetDeployedBytecode(name)
+		depBytecode, err := predeploys.ResolveDeployedBytecode(name)
 		if err != nil {
 			return err
 		}
-		log.Info("Setting deployed bytecode from solc compiler output", "name", name, "address", implAddr)
+		log.Info("Setting deployed bytecode", "name", name, "address", implAddr)
 		db.SetCode(implAddr, depBytecode)
 	}
 
@@ -27,7 +27,7 @@ type ImmutableValues map[string]any
 // contracts.
 type ImmutableConfig map[string]ImmutableValues
 
-var Create2DeployerCodeHash = common.HexToHash("0xb0550b5b431e30d38000efb7107aaa0ade03d48a7198a140edda9d27134468b2")
+var Create2DeployerCodeHash = predeploys.Create2DeployerCodeHash
 
 // Check does a sanity check that the specific values that
 // Optimism uses are set inside of the ImmutableConfig.
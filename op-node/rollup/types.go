@@ -1,10 +1,12 @@
 package rollup
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -337,6 +340,31 @@ func (c *Config) LogDescription(log log.Logger, l2Chains map[string]string) {
 	)
 }
 
+// ReferencedPredeploys returns the sorted addresses of the L2 predeploys that
+// appear in the addresses of the config. All of them are expected to be L1
+// addresses, so a non-empty result points at a misconfigured rollup config.
+func ReferencedPredeploys(cfg *Config) []common.Address {
+	candidates := []common.Address{
+		cfg.Genesis.SystemConfig.BatcherAddr,
+		cfg.BatchInboxAddress,
+		cfg.DepositContractAddress,
+		cfg.L1SystemConfigAddress,
+		cfg.ProtocolVersionsAddress,
+	}
+	seen := make(map[common.Address]bool)
+	var addrs []common.Address
+	for _, addr := range candidates {
+		if _, ok := predeploys.PredeploysByAddress[addr]; ok && !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs
+}
+
 func fmtForkTimeOrUnset(v *uint64) string {
 	if v == nil {
 		return "(not configured)"
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -447,3 +448,12 @@ func TestTimestampForBlock(t *testing.T) {
 	}
 
 }
+
+func TestReferencedPredeploys(t *testing.T) {
+	config := randConfig()
+	require.Empty(t, ReferencedPredeploys(config))
+
+	config.DepositContractAddress = predeploys.L2ToL1MessagePasserAddr
+	config.L1SystemConfigAddress = predeploys.L2ToL1MessagePasserAddr
+	require.Equal(t, []common.Address{predeploys.L2ToL1MessagePasserAddr}, ReferencedPredeploys(config))
+}